@@ -0,0 +1,117 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultBatchConcurrency is the default number of workers of the batch APIs.
+const DefaultBatchConcurrency = 8
+
+type (
+	// A BatchOption provides a customize option for the batch APIs of kenall.Client.
+	BatchOption interface {
+		//nolint: inamedparam
+		Apply(*batchConfig)
+	}
+	// A GetAddressResult is a result of each postal code of GetAddresses.
+	GetAddressResult struct {
+		Response *GetAddressResponse
+		Err      error
+	}
+
+	batchConfig struct {
+		concurrency int
+	}
+	withConcurrency struct {
+		concurrency int
+	}
+)
+
+// Apply implements kenall.BatchOption interface.
+func (w *withConcurrency) Apply(cfg *batchConfig) {
+	cfg.concurrency = w.concurrency
+}
+
+// WithConcurrency injects optional number of workers to the batch APIs. The default is DefaultBatchConcurrency.
+func WithConcurrency(n int) BatchOption {
+	return &withConcurrency{concurrency: n}
+}
+
+// GetAddresses requests to the kenall service to get the addresses by postal codes with bounded concurrency.
+// Duplicate postal codes are requested once, and the result maps each postal code to its response or error,
+// so that an error such as ErrNotFound does not fail the other postal codes.
+// With WithLenientPostalCode, the postal codes are compared after ParsePostalCode,
+// e.g. "〒100-0001" and "1000001" are requested once and share the result.
+// If ctx is done, the postal codes that have not been requested yet result in the error of ctx,
+// which is also returned.
+func (cli *Client) GetAddresses(
+	ctx context.Context, postalCodes []string, opts ...BatchOption,
+) (map[string]*GetAddressResult, error) {
+	cfg := batchConfig{concurrency: DefaultBatchConcurrency}
+	for _, opt := range opts {
+		opt.Apply(&cfg)
+	}
+
+	results := make(map[string]*GetAddressResult, len(postalCodes))
+	byCode := make(map[string]*GetAddressResult, len(postalCodes))
+	codes := make([]string, 0, len(postalCodes))
+
+	for _, code := range postalCodes {
+		key := code
+		if cli.lenient {
+			if pc, err := ParsePostalCode(code); err == nil {
+				key = pc.String()
+			}
+		}
+
+		r, ok := byCode[key]
+		if !ok {
+			//nolint: exhaustruct
+			r = &GetAddressResult{}
+			byCode[key] = r
+			codes = append(codes, key)
+		}
+
+		results[code] = r
+	}
+
+	queue := make(chan string)
+
+	var wg sync.WaitGroup
+	for range min(max(1, cfg.concurrency), max(1, len(codes))) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for code := range queue {
+				// The results are written by one worker per postal code, so they need no lock.
+				r := byCode[code]
+				r.Response, r.Err = cli.GetAddress(ctx, code)
+			}
+		}()
+	}
+
+	var err error
+
+loop:
+	for i, code := range codes {
+		select {
+		case <-ctx.Done():
+			err = fmt.Errorf("kenall: batch request is canceled: %w", ctx.Err())
+			for _, c := range codes[i:] {
+				byCode[c].Err = err
+			}
+
+			break loop
+		case queue <- code:
+		}
+	}
+
+	close(queue)
+	wg.Wait()
+
+	return results, err
+}
@@ -0,0 +1,178 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithConcurrency(0)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestClient_GetAddresses(t *testing.T) {
+	t.Parallel()
+
+	var (
+		hits     atomic.Int32
+		inFlight atomic.Int32
+		maxSeen  atomic.Int32
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxSeen.Load()
+			if n <= m || maxSeen.CompareAndSwap(m, n) {
+				break
+			}
+		}
+
+		select {
+		case <-time.After(20 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+
+		if r.URL.Path == "/postalcode/0000000" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		if _, err := w.Write(addressResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	codes := []string{"1008105", "1000001", "0000000", "1008105", "invalid", "1000002", "1000003", "1000004"}
+	results, err := cli.GetAddresses(t.Context(), codes, kenall.WithConcurrency(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 7 {
+		t.Errorf("give: %v, want: %v", len(results), 7)
+	}
+	if got := hits.Load(); got != 6 {
+		t.Errorf("give: %v, want: %v", got, 6)
+	}
+	if got := maxSeen.Load(); got > 2 {
+		t.Errorf("give: %v, want: <= %v", got, 2)
+	}
+	if r := results["1008105"]; r.Err != nil || r.Response.Addresses[0].JISX0402 != "13104" {
+		t.Errorf("give: %+v, want: %v", r, "13104")
+	}
+	if r := results["0000000"]; !errors.Is(r.Err, kenall.ErrNotFound) {
+		t.Errorf("give: %v, want: %v", r.Err, kenall.ErrNotFound)
+	}
+	if r := results["invalid"]; !errors.Is(r.Err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", r.Err, kenall.ErrInvalidArgument)
+	}
+}
+
+func TestClient_GetAddresses_LenientPostalCode(t *testing.T) {
+	t.Parallel()
+
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if r.URL.Path != "/postalcode/1008105" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		if _, err := w.Write(addressResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithLenientPostalCode())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	codes := []string{"100-8105", "〒100-8105", "1008105", "１００－８１０５", "invalid"}
+	results, err := cli.GetAddresses(t.Context(), codes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != len(codes) {
+		t.Errorf("give: %v, want: %v", len(results), len(codes))
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("give: %v, want: %v", got, 1)
+	}
+	for _, code := range codes[:4] {
+		if r := results[code]; r.Err != nil || r.Response.Addresses[0].JISX0402 != "13104" {
+			t.Errorf("give: %+v, want: %v", r, "13104")
+		}
+	}
+	if r := results["invalid"]; !errors.Is(r.Err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", r.Err, kenall.ErrInvalidArgument)
+	}
+}
+
+func TestClient_GetAddresses_CanceledContext(t *testing.T) {
+	t.Parallel()
+
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+			return
+		}
+		if _, err := w.Write(addressResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	t.Cleanup(cancel)
+
+	codes := []string{"1000001", "1000002", "1000003", "1000004", "1000005"}
+
+	start := time.Now()
+	results, err := cli.GetAddresses(ctx, codes, kenall.WithConcurrency(2))
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("give: %v, want: < %v", elapsed, 500*time.Millisecond)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("give: %v, want: %v", err, context.DeadlineExceeded)
+	}
+	if got := hits.Load(); got > 2 {
+		t.Errorf("give: %v, want: <= %v", got, 2)
+	}
+	for _, code := range codes {
+		if r := results[code]; r.Err == nil {
+			t.Errorf("an error of %s should not be nil", code)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package kenall
+
+import (
+	"container/list"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is the default duration that responses are kept in kenall.Cache.
+const DefaultCacheTTL = 24 * time.Hour
+
+type (
+	// A Cache stores the responses of the reference data from the kenall service.
+	// Responses with ETag or Last-Modified are stored without expiry to be revalidated by
+	// the conditional request after the TTL of kenall.Client, so a bounded implementation is recommended.
+	// It must be safe for concurrent use.
+	Cache interface {
+		// Get returns the value stored for the key, if it exists and has not expired.
+		Get(key string) ([]byte, bool)
+		// Set stores the value for the key, which expires after ttl. A non-positive ttl never expires.
+		Set(key string, value []byte, ttl time.Duration)
+	}
+	// An LRUCache is an in-memory kenall.Cache that evicts the least recently used value over the capacity.
+	LRUCache struct {
+		mu       sync.Mutex
+		capacity int
+		items    map[string]*list.Element
+		order    *list.List
+		now      func() time.Time
+	}
+
+	// A cacheEntry is a value stored in kenall.Cache with the validators for the conditional request.
+	cacheEntry struct {
+		Body         json.RawMessage `json:"body"`
+		ETag         string          `json:"etag,omitempty"`
+		LastModified string          `json:"last_modified,omitempty"`
+		ExpireAt     time.Time       `json:"expire_at"`
+	}
+
+	lruEntry struct {
+		key      string
+		value    []byte
+		expireAt time.Time
+	}
+)
+
+var (
+	// cacheablePaths are the path prefixes of the reference data APIs, which change at most monthly.
+	//nolint: gochecknoglobals
+	cacheablePaths = []string{"/postalcode", "/cities/", "/houjinbangou", "/holidays", "/businessdays/", "/bank"}
+
+	_ Cache = (*LRUCache)(nil)
+)
+
+// NewLRUCache creates kenall.LRUCache that holds up to capacity values.
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		mu:       sync.Mutex{},
+		capacity: max(1, capacity),
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Get implements kenall.Cache interface.
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	//nolint: forcetypeassert
+	entry := elem.Value.(*lruEntry)
+	if !entry.expireAt.IsZero() && !c.now().Before(entry.expireAt) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+
+	return entry.value, true
+}
+
+// Set implements kenall.Cache interface.
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expireAt time.Time
+	if ttl > 0 {
+		expireAt = c.now().Add(ttl)
+	}
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value = &lruEntry{key: key, value: value, expireAt: expireAt}
+		c.order.MoveToFront(elem)
+
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruEntry{key: key, value: value, expireAt: expireAt})
+
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		//nolint: forcetypeassert
+		delete(c.items, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Len returns the number of values in the cache, including expired ones that have not been evicted yet.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func isCacheable(req *http.Request) bool {
+	if req.Method != http.MethodGet {
+		return false
+	}
+
+	for _, p := range cacheablePaths {
+		if strings.HasPrefix(req.URL.Path, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cacheKey builds the key of kenall.Cache from the endpoint, the parameters and the API version of the request.
+func cacheKey(req *http.Request) string {
+	return req.Method + " " + req.URL.String() + " " + req.Header.Get("KenAll-API-Version")
+}
+
+func loadCacheEntry(cache Cache, key string) *cacheEntry {
+	b, ok := cache.Get(key)
+	if !ok {
+		return nil
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return nil
+	}
+
+	return &entry
+}
+
+// storeCacheEntry stores the body with the validators given by the header.
+// The entry with the validators is kept beyond ttl, so that it can be revalidated by the conditional request.
+func storeCacheEntry(cache Cache, key string, body []byte, header http.Header, ttl time.Duration) {
+	//nolint: exhaustruct
+	entry := cacheEntry{
+		Body:         body,
+		ETag:         header.Get("ETag"),
+		LastModified: header.Get("Last-Modified"),
+	}
+
+	if ttl > 0 {
+		entry.ExpireAt = time.Now().Add(ttl)
+	}
+
+	b, err := json.Marshal(&entry)
+	if err != nil {
+		return
+	}
+
+	if entry.ETag != "" || entry.LastModified != "" {
+		ttl = 0
+	}
+
+	cache.Set(key, b, ttl)
+}
+
+func (e *cacheEntry) isFresh(now time.Time) bool {
+	return e.ExpireAt.IsZero() || now.Before(e.ExpireAt)
+}
+
+func (e *cacheEntry) setConditionalHeaders(req *http.Request) {
+	if e.ETag != "" {
+		req.Header.Set("If-None-Match", e.ETag)
+	}
+
+	if e.LastModified != "" {
+		req.Header.Set("If-Modified-Since", e.LastModified)
+	}
+}
+
+// revalidate returns the header of 304 Not Modified completed with the validators of the entry.
+func (e *cacheEntry) revalidate(header http.Header) http.Header {
+	header = header.Clone()
+	if header.Get("ETag") == "" && e.ETag != "" {
+		header.Set("ETag", e.ETag)
+	}
+
+	if header.Get("Last-Modified") == "" && e.LastModified != "" {
+		header.Set("Last-Modified", e.LastModified)
+	}
+
+	return header
+}
+
+func isConditionalRequest(req *http.Request) bool {
+	return req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != ""
+}
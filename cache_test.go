@@ -0,0 +1,203 @@
+package kenall_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestLRUCache(t *testing.T) {
+	t.Parallel()
+
+	c := kenall.NewLRUCache(2)
+	c.Set("a", []byte("1"), 0)
+	c.Set("b", []byte("2"), 0)
+
+	if _, ok := c.Get("a"); !ok {
+		t.Error("a should be cached")
+	}
+
+	c.Set("c", []byte("3"), 0)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("b should be evicted as the least recently used")
+	}
+	if v, ok := c.Get("a"); !ok || string(v) != "1" {
+		t.Errorf("give: %s, want: %s", v, "1")
+	}
+
+	c.Set("a", []byte("4"), 0)
+	if v, ok := c.Get("a"); !ok || string(v) != "4" {
+		t.Errorf("give: %s, want: %s", v, "4")
+	}
+	if c.Len() != 2 {
+		t.Errorf("give: %d, want: %d", c.Len(), 2)
+	}
+
+	c.Set("d", []byte("5"), 10*time.Millisecond)
+	if _, ok := c.Get("d"); !ok {
+		t.Error("d should be cached")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := c.Get("d"); ok {
+		t.Error("d should be expired")
+	}
+}
+
+func TestClient_WithCache(t *testing.T) {
+	t.Parallel()
+
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+
+		var body []byte
+		switch r.URL.Path {
+		case "/postalcode/1008105":
+			body = addressResponse
+		case "/whoami":
+			body = whoamiResponse
+		default:
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if _, err := w.Write(body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cache := kenall.NewLRUCache(10)
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithCache(cache), kenall.WithCacheTTL(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 3 {
+		res, err := cli.GetAddress(t.Context(), "1008105")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC); !time.Time(res.Version).Equal(want) {
+			t.Errorf("give: %v, want: %v", time.Time(res.Version), want)
+		}
+		if res.Addresses[0].JISX0402 != "13104" {
+			t.Errorf("give: %v, want: %v", res.Addresses[0].JISX0402, "13104")
+		}
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("give: %v, want: %v", got, 1)
+	}
+
+	for range 2 {
+		if _, err := cli.GetAddress(t.Context(), "0000000"); !errors.Is(err, kenall.ErrNotFound) {
+			t.Errorf("give: %v, want: %v", err, kenall.ErrNotFound)
+		}
+	}
+	if got := hits.Load(); got != 3 {
+		t.Errorf("give: %v, want: %v", got, 3)
+	}
+
+	for range 2 {
+		if _, err := cli.GetWhoami(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := hits.Load(); got != 5 {
+		t.Errorf("give: %v, want: %v", got, 5)
+	}
+	if cache.Len() != 1 {
+		t.Errorf("give: %v, want: %v", cache.Len(), 1)
+	}
+}
+
+func TestClient_WithCache_ConditionalRequest(t *testing.T) {
+	t.Parallel()
+
+	const (
+		etag         = `"v2021-06-30"`
+		lastModified = "Wed, 30 Jun 2021 00:00:00 GMT"
+	)
+
+	var (
+		hits        atomic.Int32
+		notModified atomic.Int32
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+
+		switch r.URL.Path {
+		case "/holidays":
+			if r.Header.Get("If-None-Match") == etag {
+				notModified.Add(1)
+				w.WriteHeader(http.StatusNotModified)
+
+				return
+			}
+
+			w.Header().Set("ETag", etag)
+			if _, err := w.Write(holidaysResponse); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		case "/bank":
+			if r.Header.Get("If-Modified-Since") == lastModified {
+				notModified.Add(1)
+				w.WriteHeader(http.StatusNotModified)
+
+				return
+			}
+
+			w.Header().Set("Last-Modified", lastModified)
+			if _, err := w.Write([]byte(`{"version":"2024-01-01","data":[{"code":"0001","name":"みずほ"}]}`)); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		case "/postalcode/1008105":
+			w.WriteHeader(http.StatusNotModified)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL),
+		kenall.WithCache(kenall.NewLRUCache(10)), kenall.WithCacheTTL(time.Nanosecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 3 {
+		res, err := cli.GetHolidays(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Holidays[0].Title != "元日" {
+			t.Errorf("give: %v, want: %v", res.Holidays[0].Title, "元日")
+		}
+
+		resBanks, err := cli.GetBanks(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resBanks.Banks[0].Name != "みずほ" {
+			t.Errorf("give: %v, want: %v", resBanks.Banks[0].Name, "みずほ")
+		}
+	}
+	if got := hits.Load(); got != 6 {
+		t.Errorf("give: %v, want: %v", got, 6)
+	}
+	if got := notModified.Load(); got != 4 {
+		t.Errorf("give: %v, want: %v", got, 4)
+	}
+
+	var apiErr *kenall.APIError
+	if _, err := cli.GetAddress(t.Context(), "1008105"); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotModified {
+		t.Errorf("give: %v, want: %v", err, http.StatusNotModified)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"iter"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
@@ -30,8 +32,21 @@ type (
 		HTTPClient *http.Client
 		Endpoint   string
 
-		token string
+		token       string
+		retryPolicy *RetryPolicy
+		rateLimiter *rateLimiter
+		cache       Cache
+		cacheTTL    time.Duration
+		middlewares []Middleware
+		tracer      Tracer
+		metrics     Metrics
+		logger      *slog.Logger
+		tokenSource *cachedTokenSource
+		coalescer   *coalescer
+		lenient     bool
 	}
+	// A Middleware wraps the http.RoundTripper of kenall.Client to customize requests and responses.
+	Middleware func(http.RoundTripper) http.RoundTripper
 	// A ClientOption provides a customize option for kenall.Client.
 	ClientOption interface {
 		//nolint: inamedparam
@@ -39,35 +54,252 @@ type (
 	}
 )
 
+// The operations of kenall.Client, which are given to the instrumentation such as kenall.Tracer.
+var ( //nolint: gochecknoglobals
+	opGetAddress          = operation{name: "GetAddress", route: "/postalcode/{postal_code}"}
+	opSearchAddress       = operation{name: "SearchAddress", route: "/postalcode/"}
+	opGetCity             = operation{name: "GetCity", route: "/cities/{prefecture_code}"}
+	opGetCorporation      = operation{name: "GetCorporation", route: "/houjinbangou/{corporate_number}"}
+	opSearchCorporations  = operation{name: "SearchCorporations", route: "/houjinbangou"}
+	opGetWhoami           = operation{name: "GetWhoami", route: "/whoami"}
+	opGetHolidays         = operation{name: "GetHolidays", route: "/holidays"}
+	opGetHolidaysByYear   = operation{name: "GetHolidaysByYear", route: "/holidays"}
+	opGetHolidaysByPeriod = operation{name: "GetHolidaysByPeriod", route: "/holidays"}
+	opGetNormalizeAddress = operation{name: "GetNormalizeAddress", route: "/postalcode/"}
+	opGetBusinessDays     = operation{name: "GetBusinessDays", route: "/businessdays/check"}
+	opGetBanks            = operation{name: "GetBanks", route: "/bank"}
+	opGetBank             = operation{name: "GetBank", route: "/bank/{bank_code}"}
+	opGetBankBranches     = operation{name: "GetBankBranches", route: "/bank/{bank_code}/branches"}
+	opGetBankBranch       = operation{name: "GetBankBranch", route: "/bank/{bank_code}/branches/{branch_code}"}
+)
+
 // NewClient creates kenall.Client with the authorization token provided by the kenall service.
+// The token can be empty if kenall.WithTokenSource is given.
 func NewClient(token string, opts ...ClientOption) (*Client, error) {
-	if token == "" {
-		return nil, ErrInvalidArgument
-	}
-
 	cli := &Client{
 		HTTPClient: http.DefaultClient,
 		Endpoint:   Endpoint,
 		token:      token,
+		cacheTTL:   DefaultCacheTTL,
 	}
 
 	for _, opt := range opts {
 		opt.Apply(cli)
 	}
 
+	if cli.token == "" && cli.tokenSource == nil {
+		return nil, ErrInvalidArgument
+	}
+
 	return cli, nil
 }
 
-func (cli *Client) sendRequest(req *http.Request, res interface{}) error { //nolint: cyclop
-	req.Header.Add("Authorization", "token "+cli.token)
+type (
+	// An operation identifies the API call of kenall.Client.
+	operation struct {
+		// name is the method name of kenall.Client, e.g. "GetAddress".
+		name string
+		// route is the path template of the endpoint, e.g. "/postalcode/{postal_code}".
+		route string
+	}
+	// A call is the result of an API call of kenall.Client given to the instrumentation.
+	call struct {
+		statusCode int
+		attempts   int
+		cacheHit   bool
+	}
+	// A response is the status code, the header and the body of a response from the kenall service.
+	response struct {
+		statusCode int
+		header     http.Header
+		body       []byte
+	}
+)
+
+func (cli *Client) sendRequest(op operation, req *http.Request, res interface{}) (err error) {
+	var c call
+
+	if cli.metrics != nil {
+		start := time.Now()
+
+		defer func() {
+			cli.metrics.ObserveRequest(RequestMetric{
+				Operation:  op.name,
+				StatusCode: c.statusCode,
+				ErrorClass: ErrorClass(err),
+				Duration:   time.Since(start),
+				Attempts:   c.attempts,
+				CacheHit:   c.cacheHit,
+			})
+		}()
+	}
+
+	if cli.tracer != nil {
+		ctx, span := cli.tracer.Start(req.Context(), "kenall."+op.name)
+		req = req.WithContext(ctx)
+
+		defer func() { endSpan(span, op, req, &c, res, err) }()
+	}
+
+	return cli.send(req, res, &c)
+}
+
+func (cli *Client) send(req *http.Request, res interface{}, c *call) error {
+	var (
+		key   string
+		entry *cacheEntry
+	)
+
+	if cli.cache != nil && isCacheable(req) {
+		key = cacheKey(req)
+		if entry = loadCacheEntry(cli.cache, key); entry != nil {
+			if entry.isFresh(time.Now()) {
+				c.cacheHit = true
+
+				return decodeResponse(entry.Body, res)
+			}
+
+			entry.setConditionalHeaders(req)
+		}
+	}
+
+	if err := cli.authorize(req, false); err != nil {
+		return err
+	}
+
+	resp, err := cli.fetchShared(req, c)
+	if errors.Is(err, ErrUnauthorized) && cli.tokenSource != nil && cli.tokenSource.refreshOnUnauthorized {
+		if err := cli.authorize(req, true); err != nil {
+			return err
+		}
+
+		resp, err = cli.fetchShared(req, c)
+	}
 
-	resp, err := cli.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	body, header := resp.body, resp.header
+	if resp.statusCode == http.StatusNotModified {
+		body, header = entry.Body, entry.revalidate(header)
+	}
+
+	if err := decodeResponse(body, res); err != nil {
+		return err
+	}
+
+	if key != "" {
+		storeCacheEntry(cli.cache, key, body, header, cli.cacheTTL)
+	}
+
+	return nil
+}
+
+// authorize sets the Authorization header with the static token or the token given by the token source,
+// where refresh discards the cached token.
+func (cli *Client) authorize(req *http.Request, refresh bool) error {
+	token := cli.token
+
+	if cli.tokenSource != nil {
+		if refresh {
+			cli.tokenSource.invalidate()
+		}
+
+		var err error
+		if token, err = cli.tokenSource.token(req.Context()); err != nil {
+			return err
+		}
+	}
+
+	req.Header.Set("Authorization", "token "+token)
+
+	return nil
+}
+
+// fetchShared fetches the response, sharing it with the concurrent identical requests if the coalescing is enabled.
+func (cli *Client) fetchShared(req *http.Request, c *call) (*response, error) {
+	if cli.coalescer == nil || req.Method != http.MethodGet {
+		return cli.fetch(req, c)
+	}
+
+	return cli.coalescer.do(req, c, cli.fetch)
+}
+
+// fetch sends the request with the retry policy and returns the successful response.
+func (cli *Client) fetch(req *http.Request, c *call) (*response, error) {
+	var resp *response
+
+	send := func(req *http.Request) (int, error) {
+		start := time.Now()
+		code, r, err := cli.doRequest(req)
+		c.statusCode, resp = code, r
+		c.attempts++
+
+		if cli.logger != nil {
+			logRequest(cli.logger, req, c.attempts, code, r, time.Since(start), err)
+		}
+
+		return code, err
+	}
+
+	var err error
+	if cli.retryPolicy == nil {
+		_, err = send(req)
+	} else {
+		err = cli.retryPolicy.do(req, send)
+	}
+
+	return resp, err
+}
+
+// httpClient returns HTTPClient whose transport is wrapped by the middlewares,
+// where the first middleware is the outermost one.
+func (cli *Client) httpClient() *http.Client {
+	if len(cli.middlewares) == 0 {
+		return cli.HTTPClient
+	}
+
+	c := *cli.HTTPClient
+
+	rt := c.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	for i := len(cli.middlewares) - 1; i >= 0; i-- {
+		rt = cli.middlewares[i](rt)
+	}
+
+	c.Transport = rt
+
+	return &c
+}
+
+func decodeResponse(body []byte, res interface{}) error {
+	if err := json.Unmarshal(body, res); err != nil {
+		return fmt.Errorf("kenall: failed to decode to response: %w", err)
+	}
+
+	return nil
+}
+
+// doRequest sends the request once and returns the status code and the successful response,
+// where the status code is zero if no response is received.
+func (cli *Client) doRequest(req *http.Request) (int, *response, error) { //nolint: cyclop
+	if cli.rateLimiter != nil {
+		if err := cli.rateLimiter.wait(req.Context()); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	resp, err := cli.httpClient().Do(req)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err) {
-			return ErrTimeout(err)
+			return 0, nil, ErrTimeout(err)
 		}
 
-		return fmt.Errorf("kenall: failed to do http client with a request for kenall service: %w", err)
+		return 0, nil, fmt.Errorf("kenall: failed to do http client with a request for kenall service: %w", err)
 	}
 
 	defer func() {
@@ -77,27 +309,39 @@ func (cli *Client) sendRequest(req *http.Request, res interface{}) error { //nol
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
-			return fmt.Errorf("kenall: failed to decode to response: %w", err)
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return resp.StatusCode, nil, fmt.Errorf("kenall: failed to read the response: %w", err)
 		}
+
+		return resp.StatusCode, &response{statusCode: resp.StatusCode, header: resp.Header, body: body}, nil
+	case http.StatusNotModified:
+		if !isConditionalRequest(req) {
+			return resp.StatusCode, nil, newAPIError(req, resp, nil)
+		}
+
+		return resp.StatusCode, &response{statusCode: resp.StatusCode, header: resp.Header, body: nil}, nil
+	case http.StatusBadRequest:
+		return resp.StatusCode, nil, newAPIError(req, resp, ErrBadRequest)
 	case http.StatusUnauthorized:
-		return ErrUnauthorized
+		return resp.StatusCode, nil, newAPIError(req, resp, ErrUnauthorized)
 	case http.StatusPaymentRequired:
-		return ErrPaymentRequired
+		return resp.StatusCode, nil, newAPIError(req, resp, ErrPaymentRequired)
 	case http.StatusForbidden:
-		return ErrForbidden
+		return resp.StatusCode, nil, newAPIError(req, resp, ErrForbidden)
 	case http.StatusNotFound:
-		return ErrNotFound
+		return resp.StatusCode, nil, newAPIError(req, resp, ErrNotFound)
 	case http.StatusMethodNotAllowed:
-		return ErrMethodNotAllowed
+		return resp.StatusCode, nil, newAPIError(req, resp, ErrMethodNotAllowed)
+	case http.StatusTooManyRequests:
+		return resp.StatusCode, nil, newAPIError(req, resp, ErrTooManyRequests)
 	case http.StatusInternalServerError:
-		return ErrInternalServerError
+		return resp.StatusCode, nil, newAPIError(req, resp, ErrInternalServerError)
+	case http.StatusServiceUnavailable:
+		return resp.StatusCode, nil, newAPIError(req, resp, ErrServiceUnavailable)
 	default:
-		//nolint: goerr113
-		return fmt.Errorf("kenall: not registered in the error handling, http status code = %d", resp.StatusCode)
+		return resp.StatusCode, nil, newAPIError(req, resp, nil)
 	}
-
-	return nil
 }
 
 // A GetAddressResponse is a result from the kenall service of the API to get the address from the postal code.
@@ -108,7 +352,14 @@ type GetAddressResponse struct {
 
 // GetAddress requests to the kenall service to get the address by postal code.
 func (cli *Client) GetAddress(ctx context.Context, postalCode string) (*GetAddressResponse, error) {
-	if _, err := strconv.Atoi(postalCode); err != nil || len(postalCode) != 7 {
+	if cli.lenient {
+		p, err := ParsePostalCode(postalCode)
+		if err != nil {
+			return nil, err
+		}
+
+		postalCode = p.String()
+	} else if len(postalCode) != postalCodeLength || !isDigits(postalCode) {
 		return nil, ErrInvalidArgument
 	}
 
@@ -118,13 +369,146 @@ func (cli *Client) GetAddress(ctx context.Context, postalCode string) (*GetAddre
 	}
 
 	var res GetAddressResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest(opGetAddress, req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
 	return &res, nil
 }
 
+// A SearchAddressRequest is a request parameter to search addresses by free text.
+type SearchAddressRequest struct {
+	// Query is the free text to search addresses.
+	Query string
+	// Prefecture, City and Town narrow down the result by each field.
+	Prefecture string
+	City       string
+	Town       string
+	// Offset is the number of items to skip, and Limit is the maximum number of items to return.
+	// The kenall service applies its own default when Limit is zero.
+	Offset int
+	Limit  int
+	// Facet narrows down the result by the path of the facet, e.g. "/東京都/港区".
+	Facet string
+}
+
+func (r *SearchAddressRequest) values() (url.Values, error) {
+	v, err := searchValues(r.Query, []searchField{
+		{key: "prefecture", value: r.Prefecture},
+		{key: "city", value: r.City},
+		{key: "town", value: r.Town},
+	}, r.Offset, r.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Facet != "" {
+		v.Set("facet", r.Facet)
+	}
+
+	return v, nil
+}
+
+type searchField struct {
+	key   string
+	value string
+}
+
+// searchValues builds the query parameters shared by the search APIs of the kenall service.
+// The free text and the fields are joined into "q" with AND, and either of them is required.
+// The free text is grouped by parentheses so that an OR in it does not escape the fields.
+func searchValues(query string, fields []searchField, offset, limit int) (url.Values, error) {
+	conds := make([]string, 0, len(fields)+1)
+	for _, f := range fields {
+		if v := strings.TrimSpace(f.value); v != "" {
+			conds = append(conds, f.key+":"+strconv.Quote(v))
+		}
+	}
+
+	if q := strings.TrimSpace(query); q != "" {
+		if len(conds) > 0 {
+			q = "(" + q + ")"
+		}
+
+		conds = append([]string{q}, conds...)
+	}
+
+	if len(conds) == 0 || offset < 0 || limit < 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	v := url.Values{"q": []string{strings.Join(conds, " AND ")}}
+	if offset > 0 {
+		v.Set("offset", strconv.Itoa(offset))
+	}
+
+	if limit > 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+
+	return v, nil
+}
+
+// A SearchAddressResponse is a result from the kenall service of the API to search addresses by free text.
+type SearchAddressResponse struct {
+	Version   Version    `json:"version"`
+	Query     Query      `json:"query"`
+	Count     int        `json:"count"`
+	Offset    int        `json:"offset"`
+	Limit     int        `json:"limit"`
+	Facets    []*Facet   `json:"facets"`
+	Addresses []*Address `json:"data"`
+}
+
+// SearchAddress requests to the kenall service to search addresses by free text.
+func (cli *Client) SearchAddress(ctx context.Context, r SearchAddressRequest) (*SearchAddressResponse, error) {
+	v, err := r.values()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/postalcode/?"+v.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
+	}
+
+	var res SearchAddressResponse
+	if err := cli.sendRequest(opSearchAddress, req, &res); err != nil {
+		return nil, fmt.Errorf(errFailedRequestFormat, err)
+	}
+
+	return &res, nil
+}
+
+// SearchAddressAll returns an iterator that yields all addresses matched by the request,
+// requesting the kenall service page by page from r.Offset with r.Limit.
+// The iteration stops at the first error, which is yielded with a nil address,
+// so a canceled ctx ends the iteration at the next page request.
+func (cli *Client) SearchAddressAll(ctx context.Context, r SearchAddressRequest) iter.Seq2[*Address, error] {
+	return func(yield func(*Address, error) bool) {
+		r := r
+		for {
+			res, err := cli.SearchAddress(ctx, r)
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			for _, addr := range res.Addresses {
+				if !yield(addr, nil) {
+					return
+				}
+			}
+
+			r.Offset += len(res.Addresses)
+			if len(res.Addresses) == 0 || r.Offset >= res.Count {
+				return
+			}
+		}
+	}
+}
+
 // A GetCityResponse is a result from the kenall service of the API to get the city from the prefecture code.
 type GetCityResponse struct {
 	Version Version `json:"version"`
@@ -133,7 +517,7 @@ type GetCityResponse struct {
 
 // GetCity requests to the kenall service to get the city by prefecture code.
 func (cli *Client) GetCity(ctx context.Context, prefectureCode string) (*GetCityResponse, error) {
-	if _, err := strconv.Atoi(prefectureCode); err != nil || len(prefectureCode) != 2 {
+	if len(prefectureCode) != 2 || !isDigits(prefectureCode) {
 		return nil, ErrInvalidArgument
 	}
 
@@ -143,7 +527,7 @@ func (cli *Client) GetCity(ctx context.Context, prefectureCode string) (*GetCity
 	}
 
 	var res GetCityResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest(opGetCity, req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -159,8 +543,8 @@ type GetCorporationResponse struct {
 
 // GetCorporation requests to the kenall service to get the corporation by corporate number.
 func (cli *Client) GetCorporation(ctx context.Context, corporateNumber string) (*GetCorporationResponse, error) {
-	if _, err := strconv.Atoi(corporateNumber); err != nil || len(corporateNumber) != 13 {
-		return nil, ErrInvalidArgument
+	if err := ValidateCorporateNumber(corporateNumber); err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/houjinbangou/"+corporateNumber, nil)
@@ -169,7 +553,70 @@ func (cli *Client) GetCorporation(ctx context.Context, corporateNumber string) (
 	}
 
 	var res GetCorporationResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest(opGetCorporation, req, &res); err != nil {
+		return nil, fmt.Errorf(errFailedRequestFormat, err)
+	}
+
+	return &res, nil
+}
+
+// A SearchCorporationsRequest is a request parameter to search corporations by name.
+type SearchCorporationsRequest struct {
+	// Query is the name of the corporation to search.
+	Query string
+	// Prefecture and City narrow down the result by the location of the head office.
+	Prefecture string
+	City       string
+	// IncludeClosed includes corporations that have been closed in the result.
+	// Otherwise the query is grouped by parentheses and "NOT close_cause:*" is appended with AND.
+	IncludeClosed bool
+	// Offset is the number of items to skip, and Limit is the maximum number of items to return.
+	// The kenall service applies its own default when Limit is zero.
+	Offset int
+	Limit  int
+}
+
+func (r *SearchCorporationsRequest) values() (url.Values, error) {
+	v, err := searchValues(r.Query, []searchField{
+		{key: "prefecture_name", value: r.Prefecture},
+		{key: "city_name", value: r.City},
+	}, r.Offset, r.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.IncludeClosed {
+		v.Set("q", "("+v.Get("q")+") AND NOT close_cause:*")
+	}
+
+	return v, nil
+}
+
+// A SearchCorporationsResponse is a result from the kenall service of the API to search corporations by name.
+type SearchCorporationsResponse struct {
+	Version      Version        `json:"version"`
+	Count        int            `json:"count"`
+	Offset       int            `json:"offset"`
+	Limit        int            `json:"limit"`
+	Corporations []*Corporation `json:"data"`
+}
+
+// SearchCorporations requests to the kenall service to search corporations by name.
+func (cli *Client) SearchCorporations(
+	ctx context.Context, r SearchCorporationsRequest,
+) (*SearchCorporationsResponse, error) {
+	v, err := r.values()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/houjinbangou?"+v.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
+	}
+
+	var res SearchCorporationsResponse
+	if err := cli.sendRequest(opSearchCorporations, req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -189,7 +636,7 @@ func (cli *Client) GetWhoami(ctx context.Context) (*GetWhoamiResponse, error) {
 	}
 
 	var res GetWhoamiResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest(opGetWhoami, req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -201,14 +648,14 @@ type GetHolidaysResponse struct {
 	Holidays []*Holiday `json:"data"`
 }
 
-func (cli *Client) getHolidays(ctx context.Context, v url.Values) (*GetHolidaysResponse, error) {
+func (cli *Client) getHolidays(ctx context.Context, op operation, v url.Values) (*GetHolidaysResponse, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/holidays?"+v.Encode(), nil)
 	if err != nil {
 		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
 	}
 
 	var res GetHolidaysResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest(op, req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -217,17 +664,17 @@ func (cli *Client) getHolidays(ctx context.Context, v url.Values) (*GetHolidaysR
 
 // GetHolidays requests to the kenall service to get all holidays after 1970.
 func (cli *Client) GetHolidays(ctx context.Context) (*GetHolidaysResponse, error) {
-	return cli.getHolidays(ctx, nil)
+	return cli.getHolidays(ctx, opGetHolidays, nil)
 }
 
 // GetHolidaysByYear requests to the kenall service to get holidays for the year.
 func (cli *Client) GetHolidaysByYear(ctx context.Context, year int) (*GetHolidaysResponse, error) {
-	return cli.getHolidays(ctx, url.Values{"year": []string{strconv.Itoa(year)}})
+	return cli.getHolidays(ctx, opGetHolidaysByYear, url.Values{"year": []string{strconv.Itoa(year)}})
 }
 
 // GetHolidaysByPeriod requests to the kenall service to get holidays for the period.
 func (cli *Client) GetHolidaysByPeriod(ctx context.Context, from, to time.Time) (*GetHolidaysResponse, error) {
-	return cli.getHolidays(ctx, url.Values{
+	return cli.getHolidays(ctx, opGetHolidaysByPeriod, url.Values{
 		"from": []string{from.Format(RFC3339DateFormat)},
 		"to":   []string{to.Format(RFC3339DateFormat)},
 	})
@@ -246,13 +693,14 @@ func (cli *Client) GetNormalizeAddress(ctx context.Context, address string) (*Ge
 		return nil, ErrInvalidArgument
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/postalcode/?t="+address, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		cli.Endpoint+"/postalcode/?"+url.Values{"t": {address}}.Encode(), nil)
 	if err != nil {
 		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
 	}
 
 	var res GetNormalizeAddressResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest(opGetNormalizeAddress, req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -280,7 +728,7 @@ func (cli *Client) GetBusinessDays(ctx context.Context, date time.Time) (*GetBus
 	res := struct {
 		Result bool `json:"result"`
 	}{}
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest(opGetBusinessDays, req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -304,7 +752,32 @@ func (cli *Client) GetBanks(ctx context.Context) (*GetBanksResponse, error) {
 	}
 
 	var res GetBanksResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest(opGetBanks, req, &res); err != nil {
+		return nil, fmt.Errorf(errFailedRequestFormat, err)
+	}
+
+	return &res, nil
+}
+
+// A GetBankResponse is a result from the kenall service of the API to get the bank from the bank code.
+type GetBankResponse struct {
+	Version Version `json:"version"`
+	Bank    *Bank   `json:"data"`
+}
+
+// GetBank requests to the kenall service to get the bank by bank code.
+func (cli *Client) GetBank(ctx context.Context, bankCode string) (*GetBankResponse, error) {
+	if len(bankCode) != 4 {
+		return nil, ErrInvalidArgument
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/bank/"+bankCode, nil)
+	if err != nil {
+		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
+	}
+
+	var res GetBankResponse
+	if err := cli.sendRequest(opGetBank, req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -328,7 +801,35 @@ func (cli *Client) GetBankBranches(ctx context.Context, bankCode string) (*GetBa
 	req.Header.Set("KenAll-API-Version", "2024-01-01")
 
 	var res GetBankBranchesResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest(opGetBankBranches, req, &res); err != nil {
+		return nil, fmt.Errorf(errFailedRequestFormat, err)
+	}
+
+	return &res, nil
+}
+
+// A GetBankBranchResponse is a result from the kenall service of the API to get the branch
+// from the bank code and the branch code.
+type GetBankBranchResponse struct {
+	Version    Version    `json:"version"`
+	BankBranch BankBranch `json:"data"`
+}
+
+// GetBankBranch requests to the kenall service to get the branch by bank code and branch code.
+func (cli *Client) GetBankBranch(ctx context.Context, bankCode, branchCode string) (*GetBankBranchResponse, error) {
+	if len(bankCode) != 4 || len(branchCode) != 3 {
+		return nil, ErrInvalidArgument
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		cli.Endpoint+"/bank/"+bankCode+"/branches/"+branchCode, nil)
+	if err != nil {
+		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
+	}
+	req.Header.Set("KenAll-API-Version", "2024-01-01")
+
+	var res GetBankBranchResponse
+	if err := cli.sendRequest(opGetBankBranch, req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -11,7 +11,9 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -31,8 +33,14 @@ var (
 	holidaysResponse []byte
 	//go:embed testdata/search_address.json
 	searchAddressResponse []byte
+	//go:embed testdata/search_corporations.json
+	searchCorporationsResponse []byte
 	//go:embed testdata/business_day.json
 	businessDaysResponse []byte
+	//go:embed testdata/bank.json
+	bankResponse []byte
+	//go:embed testdata/bank_branch.json
+	bankBranchResponse []byte
 )
 
 func TestNewClient(t *testing.T) {
@@ -97,13 +105,14 @@ func TestClient_GetAddress(t *testing.T) {
 	}{
 		"Normal case":           {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), postalCode: "1008105", checkAsError: false, wantError: nil, wantJISX0402: "13104"},
 		"Invalid postal code":   {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), postalCode: "alphabet", checkAsError: false, wantError: kenall.ErrInvalidArgument, wantJISX0402: ""},
+		"Signed postal code":    {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), postalCode: "+100810", checkAsError: false, wantError: kenall.ErrInvalidArgument, wantJISX0402: ""},
 		"Not found":             {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), postalCode: "0000000", checkAsError: false, wantError: kenall.ErrNotFound, wantJISX0402: ""},
 		"Unauthorized":          {endpoint: srv.URL, token: "bad_token", ctx: t.Context(), postalCode: "0000000", checkAsError: false, wantError: kenall.ErrUnauthorized, wantJISX0402: ""},
 		"Payment Required":      {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), postalCode: "4020000", checkAsError: false, wantError: kenall.ErrPaymentRequired, wantJISX0402: ""},
 		"Forbidden":             {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), postalCode: "4030000", checkAsError: false, wantError: kenall.ErrForbidden, wantJISX0402: ""},
 		"Method Not Allowed":    {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), postalCode: "4050000", checkAsError: false, wantError: kenall.ErrMethodNotAllowed, wantJISX0402: ""},
 		"Internal server error": {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), postalCode: "5000000", checkAsError: false, wantError: kenall.ErrInternalServerError, wantJISX0402: ""},
-		"Unknown status code":   {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), postalCode: "5030000", checkAsError: true, wantError: fmt.Errorf(""), wantJISX0402: ""},
+		"Service unavailable":   {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), postalCode: "5030000", checkAsError: false, wantError: kenall.ErrServiceUnavailable, wantJISX0402: ""},
 		"Wrong endpoint":        {endpoint: "", token: "opencollector", ctx: t.Context(), postalCode: "0000000", checkAsError: true, wantError: &url.Error{}, wantJISX0402: ""},
 		"Wrong response":        {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), postalCode: "0000001", checkAsError: true, wantError: &json.MarshalerError{}, wantJISX0402: ""},
 		"Nil context":           {endpoint: srv.URL, token: "opencollector", ctx: nil, postalCode: "0000000", checkAsError: true, wantError: errors.New("net/http: nil Context"), wantJISX0402: ""},
@@ -132,6 +141,181 @@ func TestClient_GetAddress(t *testing.T) {
 	}
 }
 
+func TestClient_SearchAddress(t *testing.T) {
+	t.Parallel()
+
+	toctx, cancel := context.WithTimeout(t.Context(), time.Nanosecond)
+	srv := runTestingServer(t)
+	t.Cleanup(func() {
+		cancel()
+		srv.Close()
+	})
+
+	cases := map[string]struct {
+		endpoint     string
+		token        string
+		ctx          context.Context
+		giveRequest  kenall.SearchAddressRequest
+		checkAsError bool
+		wantError    any
+		wantCount    int
+	}{
+		"Normal case":           {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Query: "六本木"}, checkAsError: false, wantError: nil, wantCount: 1637},
+		"With filters":          {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Query: "六本木", Prefecture: "東京都", City: "港区", Offset: 100, Limit: 100, Facet: "/東京都/港区"}, checkAsError: false, wantError: nil, wantCount: 1637},
+		"With OR":               {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Query: "六本木 OR 麻布", Prefecture: "東京都"}, checkAsError: false, wantError: nil, wantCount: 1637},
+		"Only filters":          {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Town: "六本木"}, checkAsError: false, wantError: nil, wantCount: 1637},
+		"Empty request":         {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Query: " "}, checkAsError: false, wantError: kenall.ErrInvalidArgument, wantCount: 0},
+		"Negative offset":       {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Query: "六本木", Offset: -1}, checkAsError: false, wantError: kenall.ErrInvalidArgument, wantCount: 0},
+		"Negative limit":        {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Query: "六本木", Limit: -1}, checkAsError: false, wantError: kenall.ErrInvalidArgument, wantCount: 0},
+		"Not found":             {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Query: "notfound"}, checkAsError: false, wantError: kenall.ErrNotFound, wantCount: 0},
+		"Unauthorized":          {endpoint: srv.URL, token: "bad_token", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Query: "六本木"}, checkAsError: false, wantError: kenall.ErrUnauthorized, wantCount: 0},
+		"Internal server error": {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Query: "error"}, checkAsError: false, wantError: kenall.ErrInternalServerError, wantCount: 0},
+		"Wrong endpoint":        {endpoint: "", token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Query: "六本木"}, checkAsError: true, wantError: &url.Error{}, wantCount: 0},
+		"Wrong response":        {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchAddressRequest{Query: "wrong"}, checkAsError: true, wantError: &json.MarshalerError{}, wantCount: 0},
+		"Nil context":           {endpoint: srv.URL, token: "opencollector", ctx: nil, giveRequest: kenall.SearchAddressRequest{Query: "六本木"}, checkAsError: true, wantError: errors.New("net/http: nil Context"), wantCount: 0},
+		"Timeout context":       {endpoint: srv.URL, token: "opencollector", ctx: toctx, giveRequest: kenall.SearchAddressRequest{Query: "六本木"}, checkAsError: true, wantError: kenall.ErrTimeout(context.DeadlineExceeded), wantCount: 0},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient(c.token, kenall.WithEndpoint(c.endpoint))
+			if err != nil {
+				t.Error(err)
+			}
+
+			res, err := cli.SearchAddress(c.ctx, c.giveRequest)
+			if c.wantError == nil && err != nil {
+				t.Fatalf("an error should be nil, err = %s", err)
+			}
+			if c.checkAsError && !errors.As(err, &c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			} else if want, ok := c.wantError.(error); ok && !errors.Is(err, want) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if res != nil && res.Count != c.wantCount {
+				t.Errorf("give: %v, want: %v", res.Count, c.wantCount)
+			}
+			if res != nil && len(res.Addresses) != res.Limit {
+				t.Errorf("give: %v, want: %v", len(res.Addresses), res.Limit)
+			}
+		})
+	}
+}
+
+func TestClient_SearchAddressAll(t *testing.T) {
+	t.Parallel()
+
+	const total = 25
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("q") == "error" {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		if limit == 0 {
+			limit = 10
+		}
+
+		data := make([]map[string]string, 0, limit)
+		for i := offset; i < total && i < offset+limit; i++ {
+			data = append(data, map[string]string{"postal_code": fmt.Sprintf("%07d", i)})
+		}
+
+		if err := json.NewEncoder(w).Encode(map[string]any{"count": total, "offset": offset, "limit": limit, "data": data}); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		giveRequest kenall.SearchAddressRequest
+		giveBreak   int
+		wantFirst   string
+		wantLen     int
+		wantError   error
+	}{
+		"Default limit":   {giveRequest: kenall.SearchAddressRequest{Query: "六本木"}, giveBreak: 0, wantFirst: "0000000", wantLen: 25, wantError: nil},
+		"Uneven limit":    {giveRequest: kenall.SearchAddressRequest{Query: "六本木", Limit: 7}, giveBreak: 0, wantFirst: "0000000", wantLen: 25, wantError: nil},
+		"Exact limit":     {giveRequest: kenall.SearchAddressRequest{Query: "六本木", Limit: 25}, giveBreak: 0, wantFirst: "0000000", wantLen: 25, wantError: nil},
+		"With offset":     {giveRequest: kenall.SearchAddressRequest{Query: "六本木", Offset: 20, Limit: 3}, giveBreak: 0, wantFirst: "0000020", wantLen: 5, wantError: nil},
+		"Out of range":    {giveRequest: kenall.SearchAddressRequest{Query: "六本木", Offset: 30}, giveBreak: 0, wantFirst: "", wantLen: 0, wantError: nil},
+		"Break loop":      {giveRequest: kenall.SearchAddressRequest{Query: "六本木", Limit: 7}, giveBreak: 9, wantFirst: "0000000", wantLen: 9, wantError: nil},
+		"Invalid request": {giveRequest: kenall.SearchAddressRequest{}, giveBreak: 0, wantFirst: "", wantLen: 0, wantError: kenall.ErrInvalidArgument},
+		"Server error":    {giveRequest: kenall.SearchAddressRequest{Query: "error"}, giveBreak: 0, wantFirst: "", wantLen: 0, wantError: kenall.ErrInternalServerError},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var codes []string
+			for addr, err := range cli.SearchAddressAll(t.Context(), c.giveRequest) {
+				if !errors.Is(err, c.wantError) {
+					t.Errorf("give: %v, want: %v", err, c.wantError)
+				}
+				if err != nil {
+					continue
+				}
+				codes = append(codes, addr.PostalCode)
+				if len(codes) == c.giveBreak {
+					break
+				}
+			}
+			if len(codes) != c.wantLen {
+				t.Errorf("give: %v, want: %v", len(codes), c.wantLen)
+			}
+			if len(codes) > 0 && codes[0] != c.wantFirst {
+				t.Errorf("give: %v, want: %v", codes[0], c.wantFirst)
+			}
+		})
+	}
+
+	t.Run("Canceled context", func(t *testing.T) {
+		t.Parallel()
+
+		cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx, cancel := context.WithCancel(t.Context())
+		t.Cleanup(cancel)
+
+		var (
+			n       int
+			lastErr error
+		)
+		for _, err := range cli.SearchAddressAll(ctx, kenall.SearchAddressRequest{Query: "六本木", Limit: 5}) {
+			if err != nil {
+				lastErr = err
+
+				continue
+			}
+			if n++; n == 3 {
+				cancel()
+			}
+		}
+		if n != 5 {
+			t.Errorf("give: %v, want: %v", n, 5)
+		}
+		if !errors.Is(lastErr, context.Canceled) {
+			t.Errorf("give: %v, want: %v", lastErr, context.Canceled)
+		}
+	})
+}
+
 func TestClient_GetCity(t *testing.T) {
 	t.Parallel()
 
@@ -159,7 +343,7 @@ func TestClient_GetCity(t *testing.T) {
 		"Forbidden":               {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), prefectureCode: "91", checkAsError: false, wantError: kenall.ErrForbidden, wantJISX0402: ""},
 		"Method Not Allowed":      {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), prefectureCode: "96", checkAsError: false, wantError: kenall.ErrMethodNotAllowed, wantJISX0402: ""},
 		"Internal server error":   {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), prefectureCode: "92", checkAsError: false, wantError: kenall.ErrInternalServerError, wantJISX0402: ""},
-		"Unknown status code":     {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), prefectureCode: "94", checkAsError: true, wantError: fmt.Errorf(""), wantJISX0402: ""},
+		"Service unavailable":     {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), prefectureCode: "94", checkAsError: false, wantError: kenall.ErrServiceUnavailable, wantJISX0402: ""},
 		"Wrong endpoint":          {endpoint: "", token: "opencollector", ctx: t.Context(), prefectureCode: "00", checkAsError: true, wantError: &url.Error{}, wantJISX0402: ""},
 		"Wrong response":          {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), prefectureCode: "95", checkAsError: true, wantError: &json.MarshalerError{}, wantJISX0402: ""},
 		"Nil context":             {endpoint: srv.URL, token: "opencollector", ctx: nil, prefectureCode: "00", checkAsError: true, wantError: errors.New("net/http: nil Context"), wantJISX0402: ""},
@@ -209,15 +393,16 @@ func TestClient_GetCorporation(t *testing.T) {
 	}{
 		"Normal case":              {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "2021001052596", checkAsError: false, wantError: nil, wantJISX0402: "13101"},
 		"Invalid corporate number": {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "alphabet", checkAsError: false, wantError: kenall.ErrInvalidArgument, wantJISX0402: ""},
-		"Not found":                {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "0000000000001", checkAsError: false, wantError: kenall.ErrNotFound, wantJISX0402: ""},
+		"Invalid check digit":      {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "1021001052596", checkAsError: false, wantError: kenall.ErrInvalidCheckDigit, wantJISX0402: ""},
+		"Not found":                {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "8000000000001", checkAsError: false, wantError: kenall.ErrNotFound, wantJISX0402: ""},
 		"Unauthorized":             {endpoint: srv.URL, token: "bad_token", ctx: t.Context(), corporateNumber: "2021001052596", checkAsError: false, wantError: kenall.ErrUnauthorized, wantJISX0402: ""},
-		"Payment Required":         {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "0000000000402", checkAsError: false, wantError: kenall.ErrPaymentRequired, wantJISX0402: ""},
-		"Forbidden":                {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "0000000000403", checkAsError: false, wantError: kenall.ErrForbidden, wantJISX0402: ""},
-		"Method Not Allowed":       {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "0000000000405", checkAsError: false, wantError: kenall.ErrMethodNotAllowed, wantJISX0402: ""},
-		"Internal server error":    {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "0000000000500", checkAsError: false, wantError: kenall.ErrInternalServerError, wantJISX0402: ""},
-		"Unknown status code":      {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "0000000000503", checkAsError: true, wantError: fmt.Errorf(""), wantJISX0402: ""},
+		"Payment Required":         {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "3000000000402", checkAsError: false, wantError: kenall.ErrPaymentRequired, wantJISX0402: ""},
+		"Forbidden":                {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "2000000000403", checkAsError: false, wantError: kenall.ErrForbidden, wantJISX0402: ""},
+		"Method Not Allowed":       {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "9000000000405", checkAsError: false, wantError: kenall.ErrMethodNotAllowed, wantJISX0402: ""},
+		"Internal server error":    {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "4000000000500", checkAsError: false, wantError: kenall.ErrInternalServerError, wantJISX0402: ""},
+		"Service unavailable":      {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "1000000000503", checkAsError: false, wantError: kenall.ErrServiceUnavailable, wantJISX0402: ""},
 		"Wrong endpoint":           {endpoint: "", token: "opencollector", ctx: t.Context(), corporateNumber: "2021001052596", checkAsError: true, wantError: &url.Error{}, wantJISX0402: ""},
-		"Wrong response":           {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "0000000000000", checkAsError: true, wantError: &json.MarshalerError{}, wantJISX0402: ""},
+		"Wrong response":           {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), corporateNumber: "9000000000000", checkAsError: true, wantError: &json.MarshalerError{}, wantJISX0402: ""},
 		"Nil context":              {endpoint: srv.URL, token: "opencollector", ctx: nil, corporateNumber: "2021001052596", checkAsError: true, wantError: errors.New("net/http: nil Context"), wantJISX0402: ""},
 		"Timeout context":          {endpoint: srv.URL, token: "opencollector", ctx: toctx, corporateNumber: "2021001052596", checkAsError: true, wantError: kenall.ErrTimeout(context.DeadlineExceeded), wantJISX0402: ""},
 	}
@@ -240,6 +425,70 @@ func TestClient_GetCorporation(t *testing.T) {
 			if res != nil && res.Corporation.JISX0402 != c.wantJISX0402 {
 				t.Errorf("give: %v, want: %v", res.Corporation.JISX0402, c.wantJISX0402)
 			}
+			if res != nil && (res.Corporation.UpdateDate.String() != "2021-01-12" || res.Corporation.CloseDate.Valid) {
+				t.Errorf("give: %v, want: %v", res.Corporation.UpdateDate, "2021-01-12")
+			}
+		})
+	}
+}
+
+func TestClient_SearchCorporations(t *testing.T) {
+	t.Parallel()
+
+	toctx, cancel := context.WithTimeout(t.Context(), time.Nanosecond)
+	srv := runTestingServer(t)
+	t.Cleanup(func() {
+		cancel()
+		srv.Close()
+	})
+
+	cases := map[string]struct {
+		endpoint     string
+		token        string
+		ctx          context.Context
+		giveRequest  kenall.SearchCorporationsRequest
+		checkAsError bool
+		wantError    any
+		wantCount    int
+	}{
+		"Normal case":           {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchCorporationsRequest{Query: "オープンコレクター"}, checkAsError: false, wantError: nil, wantCount: 1},
+		"With filters":          {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchCorporationsRequest{Query: "オープンコレクター", Prefecture: "東京都", City: "千代田区", IncludeClosed: true, Offset: 10, Limit: 10}, checkAsError: false, wantError: nil, wantCount: 1},
+		"With OR":               {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchCorporationsRequest{Query: "A OR B"}, checkAsError: false, wantError: nil, wantCount: 1},
+		"Empty request":         {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchCorporationsRequest{IncludeClosed: true}, checkAsError: false, wantError: kenall.ErrInvalidArgument, wantCount: 0},
+		"Negative offset":       {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchCorporationsRequest{Query: "オープンコレクター", Offset: -1}, checkAsError: false, wantError: kenall.ErrInvalidArgument, wantCount: 0},
+		"Not found":             {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchCorporationsRequest{Query: "notfound"}, checkAsError: false, wantError: kenall.ErrNotFound, wantCount: 0},
+		"Unauthorized":          {endpoint: srv.URL, token: "bad_token", ctx: t.Context(), giveRequest: kenall.SearchCorporationsRequest{Query: "オープンコレクター"}, checkAsError: false, wantError: kenall.ErrUnauthorized, wantCount: 0},
+		"Internal server error": {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchCorporationsRequest{Query: "error"}, checkAsError: false, wantError: kenall.ErrInternalServerError, wantCount: 0},
+		"Wrong endpoint":        {endpoint: "", token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchCorporationsRequest{Query: "オープンコレクター"}, checkAsError: true, wantError: &url.Error{}, wantCount: 0},
+		"Wrong response":        {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveRequest: kenall.SearchCorporationsRequest{Query: "wrong"}, checkAsError: true, wantError: &json.MarshalerError{}, wantCount: 0},
+		"Nil context":           {endpoint: srv.URL, token: "opencollector", ctx: nil, giveRequest: kenall.SearchCorporationsRequest{Query: "オープンコレクター"}, checkAsError: true, wantError: errors.New("net/http: nil Context"), wantCount: 0},
+		"Timeout context":       {endpoint: srv.URL, token: "opencollector", ctx: toctx, giveRequest: kenall.SearchCorporationsRequest{Query: "オープンコレクター"}, checkAsError: true, wantError: kenall.ErrTimeout(context.DeadlineExceeded), wantCount: 0},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient(c.token, kenall.WithEndpoint(c.endpoint))
+			if err != nil {
+				t.Error(err)
+			}
+
+			res, err := cli.SearchCorporations(c.ctx, c.giveRequest)
+			if c.wantError == nil && err != nil {
+				t.Fatalf("an error should be nil, err = %s", err)
+			}
+			if c.checkAsError && !errors.As(err, &c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			} else if want, ok := c.wantError.(error); ok && !errors.Is(err, want) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if res != nil && res.Count != c.wantCount {
+				t.Errorf("give: %v, want: %v", res.Count, c.wantCount)
+			}
+			if res != nil && res.Corporations[0].CorporateNumber != "2021001052596" {
+				t.Errorf("give: %v, want: %v", res.Corporations[0].CorporateNumber, "2021001052596")
+			}
 		})
 	}
 }
@@ -465,6 +714,7 @@ func TestClient_GetNormalizeAddress(t *testing.T) {
 		wantBlockLotNum string
 	}{
 		"Normal case":    {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveAddress: "東京都港区六本木六丁目10番1号六本木ヒルズ森タワー18F", checkAsError: false, wantError: nil, wantBlockLotNum: "6-10-1"},
+		"Reserved chars": {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveAddress: "東京都港区六本木六丁目10番1号六本木ヒルズ森タワー#1801&tel=09012345678", checkAsError: false, wantError: nil, wantBlockLotNum: "6-10-1"},
 		"Empty case":     {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveAddress: "", checkAsError: true, wantError: kenall.ErrInvalidArgument, wantBlockLotNum: ""},
 		"Wrong response": {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), giveAddress: "wrong", checkAsError: true, wantError: &json.MarshalerError{}, wantBlockLotNum: ""},
 		"nil context":    {endpoint: srv.URL, token: "opencollector", ctx: nil, giveAddress: "東京都港区六本木六丁目10番1号六本木ヒルズ森タワー18F", checkAsError: true, wantError: &url.Error{}, wantBlockLotNum: ""},
@@ -538,6 +788,170 @@ func TestClient_GetBusinessDays(t *testing.T) {
 	}
 }
 
+func TestClient_GetBank(t *testing.T) {
+	t.Parallel()
+
+	toctx, cancel := context.WithTimeout(t.Context(), time.Nanosecond)
+	srv := runTestingServer(t)
+	t.Cleanup(func() {
+		cancel()
+		srv.Close()
+	})
+
+	cases := map[string]struct {
+		endpoint     string
+		token        string
+		ctx          context.Context
+		bankCode     string
+		checkAsError bool
+		wantError    any
+		wantName     string
+	}{
+		"Normal case":           {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), bankCode: "0001", checkAsError: false, wantError: nil, wantName: "みずほ"},
+		"Invalid bank code":     {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), bankCode: "001", checkAsError: false, wantError: kenall.ErrInvalidArgument, wantName: ""},
+		"Not found":             {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), bankCode: "0000", checkAsError: false, wantError: kenall.ErrNotFound, wantName: ""},
+		"Unauthorized":          {endpoint: srv.URL, token: "bad_token", ctx: t.Context(), bankCode: "0001", checkAsError: false, wantError: kenall.ErrUnauthorized, wantName: ""},
+		"Internal server error": {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), bankCode: "0500", checkAsError: false, wantError: kenall.ErrInternalServerError, wantName: ""},
+		"Wrong endpoint":        {endpoint: "", token: "opencollector", ctx: t.Context(), bankCode: "0001", checkAsError: true, wantError: &url.Error{}, wantName: ""},
+		"Wrong response":        {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), bankCode: "9999", checkAsError: true, wantError: &json.MarshalerError{}, wantName: ""},
+		"Nil context":           {endpoint: srv.URL, token: "opencollector", ctx: nil, bankCode: "0001", checkAsError: true, wantError: errors.New("net/http: nil Context"), wantName: ""},
+		"Timeout context":       {endpoint: srv.URL, token: "opencollector", ctx: toctx, bankCode: "0001", checkAsError: true, wantError: kenall.ErrTimeout(context.DeadlineExceeded), wantName: ""},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient(c.token, kenall.WithEndpoint(c.endpoint))
+			if err != nil {
+				t.Error(err)
+			}
+
+			res, err := cli.GetBank(c.ctx, c.bankCode)
+			if c.wantError == nil && err != nil {
+				t.Fatalf("an error should be nil, err = %s", err)
+			}
+			if c.checkAsError && !errors.As(err, &c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			} else if want, ok := c.wantError.(error); ok && !errors.Is(err, want) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if res != nil && res.Bank.Name != c.wantName {
+				t.Errorf("give: %v, want: %v", res.Bank.Name, c.wantName)
+			}
+		})
+	}
+}
+
+func TestClient_GetBankBranch(t *testing.T) {
+	t.Parallel()
+
+	toctx, cancel := context.WithTimeout(t.Context(), time.Nanosecond)
+	srv := runTestingServer(t)
+	t.Cleanup(func() {
+		cancel()
+		srv.Close()
+	})
+
+	cases := map[string]struct {
+		endpoint     string
+		token        string
+		ctx          context.Context
+		bankCode     string
+		branchCode   string
+		checkAsError bool
+		wantError    any
+		wantName     string
+	}{
+		"Normal case":           {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), bankCode: "0001", branchCode: "001", checkAsError: false, wantError: nil, wantName: "東京営業部"},
+		"Invalid bank code":     {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), bankCode: "001", branchCode: "001", checkAsError: false, wantError: kenall.ErrInvalidArgument, wantName: ""},
+		"Invalid branch code":   {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), bankCode: "0001", branchCode: "0001", checkAsError: false, wantError: kenall.ErrInvalidArgument, wantName: ""},
+		"Not found":             {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), bankCode: "0001", branchCode: "000", checkAsError: false, wantError: kenall.ErrNotFound, wantName: ""},
+		"Unauthorized":          {endpoint: srv.URL, token: "bad_token", ctx: t.Context(), bankCode: "0001", branchCode: "001", checkAsError: false, wantError: kenall.ErrUnauthorized, wantName: ""},
+		"Internal server error": {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), bankCode: "0001", branchCode: "500", checkAsError: false, wantError: kenall.ErrInternalServerError, wantName: ""},
+		"Wrong endpoint":        {endpoint: "", token: "opencollector", ctx: t.Context(), bankCode: "0001", branchCode: "001", checkAsError: true, wantError: &url.Error{}, wantName: ""},
+		"Wrong response":        {endpoint: srv.URL, token: "opencollector", ctx: t.Context(), bankCode: "0001", branchCode: "999", checkAsError: true, wantError: &json.MarshalerError{}, wantName: ""},
+		"Nil context":           {endpoint: srv.URL, token: "opencollector", ctx: nil, bankCode: "0001", branchCode: "001", checkAsError: true, wantError: errors.New("net/http: nil Context"), wantName: ""},
+		"Timeout context":       {endpoint: srv.URL, token: "opencollector", ctx: toctx, bankCode: "0001", branchCode: "001", checkAsError: true, wantError: kenall.ErrTimeout(context.DeadlineExceeded), wantName: ""},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient(c.token, kenall.WithEndpoint(c.endpoint))
+			if err != nil {
+				t.Error(err)
+			}
+
+			res, err := cli.GetBankBranch(c.ctx, c.bankCode, c.branchCode)
+			if c.wantError == nil && err != nil {
+				t.Fatalf("an error should be nil, err = %s", err)
+			}
+			if c.checkAsError && !errors.As(err, &c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			} else if want, ok := c.wantError.(error); ok && !errors.Is(err, want) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if res != nil && res.BankBranch.Branch.Name != c.wantName {
+				t.Errorf("give: %v, want: %v", res.BankBranch.Branch.Name, c.wantName)
+			}
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestClient_WithMiddleware(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	record := func(name string) kenall.Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				mu.Lock()
+				calls = append(calls, name+":"+r.Header.Get("Authorization"))
+				mu.Unlock()
+
+				return next.RoundTrip(r)
+			})
+		}
+	}
+
+	httpClient := &http.Client{}
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithHTTPClient(httpClient),
+		kenall.WithMiddleware(record("first"), record("second")),
+		kenall.WithMiddleware(func(next http.RoundTripper) http.RoundTripper { return record("third")(next) }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetWhoami(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"first:token opencollector", "second:token opencollector", "third:token opencollector"}
+	if strings.Join(calls, ",") != strings.Join(want, ",") {
+		t.Errorf("give: %v, want: %v", calls, want)
+	}
+	if httpClient.Transport != nil {
+		t.Errorf("give: %v, want: %v", httpClient.Transport, nil)
+	}
+}
+
 func ExampleClient_GetAddress() {
 	if testing.Short() {
 		// stab
@@ -739,6 +1153,8 @@ func runTestingServer(t *testing.T) *httptest.Server {
 			handlePostalAPI(t, w, uri)
 		case strings.HasPrefix(uri, "/cities/"):
 			handleCityAPI(t, w, uri)
+		case strings.HasPrefix(uri, "/houjinbangou?"):
+			handleSearchCorporationsAPI(t, w, r.URL.Query())
 		case strings.HasPrefix(uri, "/houjinbangou/"):
 			handleCorporationAPI(t, w, uri)
 		case strings.HasPrefix(uri, "/whoami"):
@@ -747,6 +1163,8 @@ func runTestingServer(t *testing.T) *httptest.Server {
 			handleHolidaysAPI(t, w, uri)
 		case strings.HasPrefix(uri, "/businessdays"):
 			handleBusinessDaysAPI(t, w, uri)
+		case strings.HasPrefix(uri, "/bank/"):
+			handleBankAPI(t, w, uri)
 		default:
 			w.WriteHeader(http.StatusNotFound)
 		}
@@ -760,8 +1178,14 @@ func handlePostalAPI(t *testing.T, w http.ResponseWriter, uri string) {
 		//nolint: errcheck
 		u, _ := url.Parse(uri)
 
+		if q := u.Query(); q.Has("q") {
+			handleSearchAddressAPI(t, w, q)
+
+			return
+		}
+
 		switch u.Query().Get("t") {
-		case "東京都港区六本木六丁目10番1号六本木ヒルズ森タワー18F":
+		case "東京都港区六本木六丁目10番1号六本木ヒルズ森タワー18F", "東京都港区六本木六丁目10番1号六本木ヒルズ森タワー#1801&tel=09012345678":
 			if _, err := w.Write(searchAddressResponse); err != nil {
 				w.WriteHeader(http.StatusInternalServerError)
 			}
@@ -796,6 +1220,31 @@ func handlePostalAPI(t *testing.T, w http.ResponseWriter, uri string) {
 	}
 }
 
+func handleSearchAddressAPI(t *testing.T, w http.ResponseWriter, v url.Values) {
+	t.Helper()
+
+	//nolint: errcheck
+	query, _ := url.QueryUnescape(v.Encode())
+
+	switch query {
+	case "q=六本木",
+		"q=town:\"六本木\"",
+		"facet=/東京都/港区&limit=100&offset=100&q=(六本木) AND prefecture:\"東京都\" AND city:\"港区\"",
+		"q=(六本木 OR 麻布) AND prefecture:\"東京都\"":
+		if _, err := w.Write(searchAddressResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	case "q=wrong":
+		if _, err := w.Write([]byte("wrong")); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	case "q=error":
+		w.WriteHeader(http.StatusInternalServerError)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
 func handleCityAPI(t *testing.T, w http.ResponseWriter, uri string) {
 	t.Helper()
 
@@ -831,17 +1280,17 @@ func handleCorporationAPI(t *testing.T, w http.ResponseWriter, uri string) {
 		if _, err := w.Write(corporationResponse); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
-	case "/houjinbangou/0000000000402":
+	case "/houjinbangou/3000000000402":
 		w.WriteHeader(http.StatusPaymentRequired)
-	case "/houjinbangou/0000000000403":
+	case "/houjinbangou/2000000000403":
 		w.WriteHeader(http.StatusForbidden)
-	case "/houjinbangou/0000000000405":
+	case "/houjinbangou/9000000000405":
 		w.WriteHeader(http.StatusMethodNotAllowed)
-	case "/houjinbangou/0000000000500":
+	case "/houjinbangou/4000000000500":
 		w.WriteHeader(http.StatusInternalServerError)
-	case "/houjinbangou/0000000000503":
+	case "/houjinbangou/1000000000503":
 		w.WriteHeader(http.StatusServiceUnavailable)
-	case "/houjinbangou/0000000000000":
+	case "/houjinbangou/9000000000000":
 		if _, err := w.Write([]byte("wrong")); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
@@ -850,6 +1299,30 @@ func handleCorporationAPI(t *testing.T, w http.ResponseWriter, uri string) {
 	}
 }
 
+func handleSearchCorporationsAPI(t *testing.T, w http.ResponseWriter, v url.Values) {
+	t.Helper()
+
+	//nolint: errcheck
+	query, _ := url.QueryUnescape(v.Encode())
+
+	switch query {
+	case "q=(オープンコレクター) AND NOT close_cause:*",
+		"q=(A OR B) AND NOT close_cause:*",
+		"limit=10&offset=10&q=(オープンコレクター) AND prefecture_name:\"東京都\" AND city_name:\"千代田区\"":
+		if _, err := w.Write(searchCorporationsResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	case "q=(wrong) AND NOT close_cause:*":
+		if _, err := w.Write([]byte("wrong")); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	case "q=(error) AND NOT close_cause:*":
+		w.WriteHeader(http.StatusInternalServerError)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
 func handleWhoamiAPI(t *testing.T, w http.ResponseWriter, uri string) {
 	t.Helper()
 
@@ -914,3 +1387,26 @@ func handleBusinessDaysAPI(t *testing.T, w http.ResponseWriter, uri string) {
 		w.WriteHeader(http.StatusNotFound)
 	}
 }
+
+func handleBankAPI(t *testing.T, w http.ResponseWriter, uri string) {
+	t.Helper()
+
+	switch uri {
+	case "/bank/0001":
+		if _, err := w.Write(bankResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	case "/bank/0001/branches/001":
+		if _, err := w.Write(bankBranchResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	case "/bank/0500", "/bank/0001/branches/500":
+		w.WriteHeader(http.StatusInternalServerError)
+	case "/bank/9999", "/bank/0001/branches/999":
+		if _, err := w.Write([]byte("wrong")); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
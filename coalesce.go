@@ -0,0 +1,95 @@
+package kenall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+type (
+	// A coalescer collapses concurrent identical requests into one in-flight request.
+	coalescer struct {
+		mu      sync.Mutex
+		flights map[string]*flight
+	}
+	// A flight is an in-flight request shared by the callers.
+	flight struct {
+		done    chan struct{}
+		cancel  context.CancelFunc
+		waiters int
+		resp    *response
+		call    call
+		err     error
+	}
+)
+
+func newCoalescer() *coalescer {
+	return &coalescer{
+		mu:      sync.Mutex{},
+		flights: make(map[string]*flight),
+	}
+}
+
+// do sends the request by fetch, or waits for the in-flight identical request.
+// The shared request is detached from the context of each caller, and it is canceled only when all callers leave.
+func (g *coalescer) do(
+	req *http.Request, c *call, fetch func(*http.Request, *call) (*response, error),
+) (*response, error) {
+	key := cacheKey(req) + " " + req.Header.Get("If-None-Match") + " " + req.Header.Get("If-Modified-Since")
+	ctx := req.Context()
+
+	g.mu.Lock()
+
+	f, ok := g.flights[key]
+	if !ok {
+		sctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		//nolint: exhaustruct
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.flights[key] = f
+
+		go func() {
+			f.resp, f.err = fetch(req.WithContext(sctx), &f.call)
+
+			g.forget(key, f)
+			cancel()
+			close(f.done)
+		}()
+	}
+
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		c.statusCode, c.attempts = f.call.statusCode, f.call.attempts
+
+		return f.resp, f.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		if f.waiters--; f.waiters == 0 {
+			f.cancel()
+
+			if g.flights[key] == f {
+				delete(g.flights, key)
+			}
+		}
+		g.mu.Unlock()
+
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, ErrTimeout(ctx.Err())
+		}
+
+		return nil, fmt.Errorf("kenall: failed to wait for the shared request: %w", ctx.Err())
+	}
+}
+
+func (g *coalescer) forget(key string, f *flight) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+}
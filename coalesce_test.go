@@ -0,0 +1,150 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+// A waitingContext reports to joined when a caller starts waiting on it,
+// which the coalesced callers do only after joining the in-flight request.
+type waitingContext struct {
+	context.Context //nolint: containedctx
+	once            sync.Once
+	joined          chan<- struct{}
+}
+
+func (wc *waitingContext) Done() <-chan struct{} {
+	wc.once.Do(func() { wc.joined <- struct{}{} })
+
+	return wc.Context.Done()
+}
+
+func TestClient_RequestCoalescing(t *testing.T) {
+	t.Parallel()
+
+	const n = 5
+
+	var (
+		hits    atomic.Int32
+		arrived = make(chan struct{})
+		release = make(chan struct{})
+		joined  = make(chan struct{}, n+1)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			close(arrived)
+		}
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		if _, err := w.Write(addressResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithRequestCoalescing())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The canceled caller must only detach itself from the shared request.
+	ctx, cancel := context.WithCancel(t.Context())
+	canceled := make(chan error, 1)
+	go func() {
+		_, err := cli.GetAddress(&waitingContext{Context: ctx, joined: joined}, "1008105")
+		canceled <- err
+	}()
+
+	<-arrived
+	<-joined
+
+	var (
+		wg   sync.WaitGroup
+		errs = make(chan error, n)
+	)
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := cli.GetAddress(&waitingContext{Context: t.Context(), joined: joined}, "1008105")
+			if err == nil && res.Addresses[0].JISX0402 != "13104" {
+				err = errors.New("unexpected response")
+			}
+			errs <- err
+		}()
+	}
+
+	for range n {
+		<-joined
+	}
+
+	cancel()
+	if err := <-canceled; !errors.Is(err, context.Canceled) {
+		t.Errorf("give: %v, want: %v", err, context.Canceled)
+	}
+
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("give: %v, want: %v", err, nil)
+		}
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("give: %v, want: %v", got, 1)
+	}
+}
+
+func TestClient_RequestCoalescingAllCanceled(t *testing.T) {
+	t.Parallel()
+
+	var hits atomic.Int32
+	aborted := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) == 1 {
+			<-r.Context().Done()
+			close(aborted)
+
+			return
+		}
+		if _, err := w.Write(addressResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithRequestCoalescing())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	t.Cleanup(cancel)
+
+	if _, err := cli.GetAddress(ctx, "1008105"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("give: %v, want: %v", err, context.DeadlineExceeded)
+	}
+
+	select {
+	case <-aborted:
+	case <-time.After(time.Second):
+		t.Fatal("the shared request should be canceled when all callers leave")
+	}
+
+	if _, err := cli.GetAddress(t.Context(), "1008105"); err != nil {
+		t.Errorf("give: %v, want: %v", err, nil)
+	}
+}
@@ -0,0 +1,44 @@
+package kenall
+
+// corporateNumberLength is the number of digits of a corporate number including the check digit.
+const corporateNumberLength = 13
+
+// ValidateCorporateNumber validates the corporate number by the check digit defined by the National Tax Agency.
+// It returns ErrInvalidArgument if the number is not 13 digits, and ErrInvalidCheckDigit if the check digit is wrong.
+func ValidateCorporateNumber(corporateNumber string) error {
+	if len(corporateNumber) != corporateNumberLength || !isDigits(corporateNumber) {
+		return ErrInvalidArgument
+	}
+
+	if d, _ := CorporateNumberCheckDigit(corporateNumber[1:]); int(corporateNumber[0]-'0') != d {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+// CorporateNumberCheckDigit computes the check digit, the first digit of a corporate number, for the 12-digit base.
+// The check digit is 9 minus the remainder of dividing by 9 the sum of the digits weighted 1 and 2 alternately
+// from the last digit.
+func CorporateNumberCheckDigit(base string) (int, error) {
+	if len(base) != corporateNumberLength-1 || !isDigits(base) {
+		return 0, ErrInvalidArgument
+	}
+
+	var sum int
+	for i := range len(base) {
+		sum += int(base[len(base)-1-i]-'0') * (i%2 + 1)
+	}
+
+	return 9 - sum%9, nil //nolint: mnd
+}
+
+func isDigits(s string) bool {
+	for i := range len(s) {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
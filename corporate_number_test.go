@@ -0,0 +1,69 @@
+package kenall_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestValidateCorporateNumber(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		wantError error
+	}{
+		"Valid":             {give: "2021001052596", wantError: nil},
+		"Valid with 9":      {give: "7000012050002", wantError: nil},
+		"Wrong check digit": {give: "1021001052596", wantError: kenall.ErrInvalidCheckDigit},
+		"Swapped digits":    {give: "2021001052569", wantError: kenall.ErrInvalidCheckDigit},
+		"Too short":         {give: "202100105259", wantError: kenall.ErrInvalidArgument},
+		"Sign":              {give: "+021001052596", wantError: kenall.ErrInvalidArgument},
+		"Full-width digits": {give: "２021001052596", wantError: kenall.ErrInvalidArgument},
+		"Empty":             {give: "", wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := kenall.ValidateCorporateNumber(c.give); !errors.Is(err, c.wantError) || (c.wantError == nil && err != nil) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+		})
+	}
+
+	if !errors.Is(kenall.ErrInvalidCheckDigit, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", kenall.ErrInvalidCheckDigit, kenall.ErrInvalidArgument)
+	}
+}
+
+func TestCorporateNumberCheckDigit(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		want      int
+		wantError error
+	}{
+		"OpenCollector": {give: "021001052596", want: 2, wantError: nil},
+		"Zero":          {give: "000000000000", want: 9, wantError: nil},
+		"Too long":      {give: "2021001052596", want: 0, wantError: kenall.ErrInvalidArgument},
+		"Alphabet":      {give: "02100105259a", want: 0, wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := kenall.CorporateNumberCheckDigit(c.give)
+			if !errors.Is(err, c.wantError) || (c.wantError == nil && err != nil) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
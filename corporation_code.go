@@ -0,0 +1,239 @@
+package kenall
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The kinds of the corporation defined by the National Tax Agency.
+const (
+	CorporationKindNationalAgency     CorporationKind = "101"
+	CorporationKindLocalGovernment    CorporationKind = "201"
+	CorporationKindStockCompany       CorporationKind = "301"
+	CorporationKindLimitedCompany     CorporationKind = "302"
+	CorporationKindGeneralPartnership CorporationKind = "303"
+	CorporationKindLimitedPartnership CorporationKind = "304"
+	CorporationKindLimitedLiability   CorporationKind = "305"
+	CorporationKindOtherRegistered    CorporationKind = "399"
+	CorporationKindForeignCompany     CorporationKind = "401"
+	CorporationKindOther              CorporationKind = "499"
+)
+
+// The process codes of the update of the corporation defined by the National Tax Agency.
+const (
+	ProcessCodeNew                     ProcessCode = "01"
+	ProcessCodeChangeName              ProcessCode = "11"
+	ProcessCodeChangeDomesticAddress   ProcessCode = "12"
+	ProcessCodeChangeOverseasAddress   ProcessCode = "13"
+	ProcessCodeClose                   ProcessCode = "21"
+	ProcessCodeRestore                 ProcessCode = "22"
+	ProcessCodeAbsorptionMerger        ProcessCode = "71"
+	ProcessCodeInvalidAbsorptionMerger ProcessCode = "72"
+	ProcessCodeEraseTradeName          ProcessCode = "81"
+	ProcessCodeDelete                  ProcessCode = "99"
+)
+
+// The correct codes of the update of the corporation defined by the National Tax Agency.
+const (
+	CorrectCodeNone       CorrectCode = "0"
+	CorrectCodeCorrection CorrectCode = "1"
+)
+
+// The causes of the closure of the corporation defined by the National Tax Agency.
+const (
+	CloseCauseCodeLiquidation      CloseCauseCode = "01"
+	CloseCauseCodeMerger           CloseCauseCode = "11"
+	CloseCauseCodeRegistrar        CloseCauseCode = "21"
+	CloseCauseCodeOtherLiquidation CloseCauseCode = "31"
+)
+
+type (
+	// A SequenceNumber is the sequence number of the update of the corporation, e.g. "1409569".
+	SequenceNumber string
+	// A CorporationKind is the kind of the corporation, e.g. "301" for a stock company.
+	CorporationKind string
+	// A ProcessCode is the process of the update of the corporation, e.g. "12" for a change of the domestic address.
+	ProcessCode string
+	// A CorrectCode tells whether the update of the corporation is a correction.
+	CorrectCode string
+	// A CloseCauseCode is the cause of the closure of the corporation, which is empty if the corporation is active.
+	CloseCauseCode string
+
+	codeLabel struct {
+		ja string
+		en string
+	}
+)
+
+var ( //nolint: gochecknoglobals
+	corporationKindLabels = map[CorporationKind]codeLabel{
+		CorporationKindNationalAgency:     {ja: "国の機関", en: "National government agency"},
+		CorporationKindLocalGovernment:    {ja: "地方公共団体", en: "Local government"},
+		CorporationKindStockCompany:       {ja: "株式会社", en: "Stock company"},
+		CorporationKindLimitedCompany:     {ja: "有限会社", en: "Limited company"},
+		CorporationKindGeneralPartnership: {ja: "合名会社", en: "General partnership company"},
+		CorporationKindLimitedPartnership: {ja: "合資会社", en: "Limited partnership company"},
+		CorporationKindLimitedLiability:   {ja: "合同会社", en: "Limited liability company"},
+		CorporationKindOtherRegistered:    {ja: "その他の設立登記法人", en: "Other registered corporation"},
+		CorporationKindForeignCompany:     {ja: "外国会社等", en: "Foreign company"},
+		CorporationKindOther:              {ja: "その他", en: "Other"},
+	}
+	processCodeLabels = map[ProcessCode]codeLabel{
+		ProcessCodeNew:                     {ja: "新規", en: "New"},
+		ProcessCodeChangeName:              {ja: "商号又は名称の変更", en: "Change of name"},
+		ProcessCodeChangeDomesticAddress:   {ja: "国内所在地の変更", en: "Change of domestic address"},
+		ProcessCodeChangeOverseasAddress:   {ja: "国外所在地の変更", en: "Change of overseas address"},
+		ProcessCodeClose:                   {ja: "登記記録の閉鎖等", en: "Closure of registration"},
+		ProcessCodeRestore:                 {ja: "登記記録の復活等", en: "Restoration of registration"},
+		ProcessCodeAbsorptionMerger:        {ja: "吸収合併", en: "Absorption-type merger"},
+		ProcessCodeInvalidAbsorptionMerger: {ja: "吸収合併無効", en: "Invalidation of absorption-type merger"},
+		ProcessCodeEraseTradeName:          {ja: "商号の登記の抹消", en: "Erasure of trade name registration"},
+		ProcessCodeDelete:                  {ja: "削除", en: "Deletion"},
+	}
+	correctCodeLabels = map[CorrectCode]codeLabel{
+		CorrectCodeNone:       {ja: "訂正以外", en: "Not a correction"},
+		CorrectCodeCorrection: {ja: "訂正", en: "Correction"},
+	}
+	closeCauseCodeLabels = map[CloseCauseCode]codeLabel{
+		CloseCauseCodeLiquidation:      {ja: "清算の結了等", en: "Completion of liquidation"},
+		CloseCauseCodeMerger:           {ja: "合併による解散等", en: "Dissolution by merger"},
+		CloseCauseCodeRegistrar:        {ja: "登記官による閉鎖", en: "Closure by registrar"},
+		CloseCauseCodeOtherLiquidation: {ja: "その他の清算の結了等", en: "Other completion of liquidation"},
+	}
+
+	_ json.Unmarshaler = (*SequenceNumber)(nil)
+	_ json.Unmarshaler = (*CorporationKind)(nil)
+	_ json.Unmarshaler = (*ProcessCode)(nil)
+	_ json.Unmarshaler = (*CorrectCode)(nil)
+	_ json.Unmarshaler = (*CloseCauseCode)(nil)
+
+	_ json.Marshaler = (*CloseCauseCode)(nil)
+)
+
+// String implements fmt.Stringer interface.
+func (n SequenceNumber) String() string { return string(n) }
+
+// Int64 returns the sequence number as an integer.
+func (n SequenceNumber) Int64() (int64, error) {
+	//nolint: wrapcheck
+	return strconv.ParseInt(string(n), 10, 64)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (n *SequenceNumber) UnmarshalJSON(data []byte) error { return unmarshalCode(data, 0, n) }
+
+// String implements fmt.Stringer interface, and returns the Japanese label, or the code if it is unknown.
+func (k CorporationKind) String() string { return labelOf(corporationKindLabels, k).ja }
+
+// EnString returns the English label, or the code if it is unknown.
+func (k CorporationKind) EnString() string { return labelOf(corporationKindLabels, k).en }
+
+// IsStockCompany reports whether the corporation is a stock company, kabushiki kaisha.
+func (k CorporationKind) IsStockCompany() bool { return k == CorporationKindStockCompany }
+
+// IsGovernment reports whether the corporation is a national government agency or a local government.
+func (k CorporationKind) IsGovernment() bool {
+	return k == CorporationKindNationalAgency || k == CorporationKindLocalGovernment
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (k *CorporationKind) UnmarshalJSON(data []byte) error { return unmarshalCode(data, 3, k) }
+
+// String implements fmt.Stringer interface, and returns the Japanese label, or the code if it is unknown.
+func (p ProcessCode) String() string { return labelOf(processCodeLabels, p).ja }
+
+// EnString returns the English label, or the code if it is unknown.
+func (p ProcessCode) EnString() string { return labelOf(processCodeLabels, p).en }
+
+// IsClosed reports whether the update is the closure of the registration.
+func (p ProcessCode) IsClosed() bool { return p == ProcessCodeClose }
+
+// IsDeleted reports whether the update is the deletion of the corporation.
+func (p ProcessCode) IsDeleted() bool { return p == ProcessCodeDelete }
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (p *ProcessCode) UnmarshalJSON(data []byte) error { return unmarshalCode(data, 2, p) }
+
+// String implements fmt.Stringer interface, and returns the Japanese label, or the code if it is unknown.
+func (c CorrectCode) String() string { return labelOf(correctCodeLabels, c).ja }
+
+// EnString returns the English label, or the code if it is unknown.
+func (c CorrectCode) EnString() string { return labelOf(correctCodeLabels, c).en }
+
+// IsCorrection reports whether the update is a correction.
+func (c CorrectCode) IsCorrection() bool { return c == CorrectCodeCorrection }
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (c *CorrectCode) UnmarshalJSON(data []byte) error { return unmarshalCode(data, 1, c) }
+
+// String implements fmt.Stringer interface, and returns the Japanese label, or the code if it is unknown.
+func (c CloseCauseCode) String() string { return labelOf(closeCauseCodeLabels, c).ja }
+
+// EnString returns the English label, or the code if it is unknown.
+func (c CloseCauseCode) EnString() string { return labelOf(closeCauseCodeLabels, c).en }
+
+// IsClosed reports whether the corporation is closed.
+func (c CloseCauseCode) IsClosed() bool { return c != "" }
+
+// MarshalJSON implements json.Marshaler interface, and returns null if the corporation is active.
+func (c CloseCauseCode) MarshalJSON() ([]byte, error) {
+	if c == "" {
+		return nullLiteral, nil
+	}
+
+	//nolint: wrapcheck
+	return json.Marshal(string(c))
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (c *CloseCauseCode) UnmarshalJSON(data []byte) error { return unmarshalCode(data, 2, c) }
+
+func labelOf[T ~string](labels map[T]codeLabel, code T) codeLabel {
+	if l, ok := labels[code]; ok {
+		return l
+	}
+
+	return codeLabel{ja: string(code), en: string(code)}
+}
+
+// unmarshalCode parses a code given as a JSON string or number, and pads the number with zeros to the width.
+func unmarshalCode[T ~string](data []byte, width int, code *T) error {
+	if bytes.Equal(data, nullLiteral) {
+		*code = ""
+
+		return nil
+	}
+
+	if data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("kenall: failed to parse the code: %w", err)
+		}
+
+		*code = T(s)
+
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("kenall: failed to parse the code: %w", err)
+	}
+
+	s := n.String()
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+
+	*code = T(s)
+
+	return nil
+}
+
+// IsClosed reports whether the corporation is closed, by the close cause or the close date.
+func (c *Corporation) IsClosed() bool {
+	return c.CloseCause.IsClosed() || c.CloseDate.Valid
+}
@@ -0,0 +1,111 @@
+package kenall_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestCorporationCodes_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type codes struct {
+		Kind       kenall.CorporationKind `json:"kind"`
+		Process    kenall.ProcessCode     `json:"process"`
+		Correct    kenall.CorrectCode     `json:"correct"`
+		CloseCause kenall.CloseCauseCode  `json:"close_cause"`
+	}
+
+	cases := map[string]struct {
+		give      string
+		want      codes
+		wantError bool
+	}{
+		"Strings":       {give: `{"kind":"301","process":"01","correct":"1","close_cause":"11"}`, want: codes{Kind: "301", Process: "01", Correct: "1", CloseCause: "11"}, wantError: false},
+		"Numbers":       {give: `{"kind":101,"process":1,"correct":0,"close_cause":1}`, want: codes{Kind: "101", Process: "01", Correct: "0", CloseCause: "01"}, wantError: false},
+		"Null":          {give: `{"kind":null,"process":null,"correct":null,"close_cause":null}`, want: codes{Kind: "", Process: "", Correct: "", CloseCause: ""}, wantError: false},
+		"Empty string":  {give: `{"close_cause":""}`, want: codes{Kind: "", Process: "", Correct: "", CloseCause: ""}, wantError: false},
+		"Wrong type":    {give: `{"kind":true}`, want: codes{Kind: "", Process: "", Correct: "", CloseCause: ""}, wantError: true},
+		"Wrong element": {give: `{"process":[1]}`, want: codes{Kind: "", Process: "", Correct: "", CloseCause: ""}, wantError: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got codes
+			err := json.Unmarshal([]byte(c.give), &got)
+			if err == nil == c.wantError {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if !c.wantError && got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestCorporationCodes_String(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give   interface{ EnString() string }
+		wantJa string
+		wantEn string
+	}{
+		"Stock company":         {give: kenall.CorporationKindStockCompany, wantJa: "株式会社", wantEn: "Stock company"},
+		"Unknown kind":          {give: kenall.CorporationKind("999"), wantJa: "999", wantEn: "999"},
+		"Change of the address": {give: kenall.ProcessCodeChangeDomesticAddress, wantJa: "国内所在地の変更", wantEn: "Change of domestic address"},
+		"Correction":            {give: kenall.CorrectCodeCorrection, wantJa: "訂正", wantEn: "Correction"},
+		"Merger":                {give: kenall.CloseCauseCodeMerger, wantJa: "合併による解散等", wantEn: "Dissolution by merger"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := c.give.(interface{ String() string }).String(); got != c.wantJa {
+				t.Errorf("give: %v, want: %v", got, c.wantJa)
+			}
+			if got := c.give.EnString(); got != c.wantEn {
+				t.Errorf("give: %v, want: %v", got, c.wantEn)
+			}
+		})
+	}
+}
+
+func TestCorporationCodes_Predicates(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give bool
+		want bool
+	}{
+		"Stock company":           {give: kenall.CorporationKindStockCompany.IsStockCompany(), want: true},
+		"Limited company":         {give: kenall.CorporationKindLimitedCompany.IsStockCompany(), want: false},
+		"Local government":        {give: kenall.CorporationKindLocalGovernment.IsGovernment(), want: true},
+		"Foreign company":         {give: kenall.CorporationKindForeignCompany.IsGovernment(), want: false},
+		"Closed process":          {give: kenall.ProcessCodeClose.IsClosed(), want: true},
+		"Restored process":        {give: kenall.ProcessCodeRestore.IsClosed(), want: false},
+		"Deleted process":         {give: kenall.ProcessCodeDelete.IsDeleted(), want: true},
+		"Correction":              {give: kenall.CorrectCodeCorrection.IsCorrection(), want: true},
+		"Not a correction":        {give: kenall.CorrectCodeNone.IsCorrection(), want: false},
+		"Closed by liquidation":   {give: kenall.CloseCauseCodeLiquidation.IsClosed(), want: true},
+		"Active":                  {give: kenall.CloseCauseCode("").IsClosed(), want: false},
+		"Active corporation":      {give: (&kenall.Corporation{}).IsClosed(), want: false},
+		"Closed corporation":      {give: (&kenall.Corporation{CloseCause: kenall.CloseCauseCodeMerger}).IsClosed(), want: true},
+		"Corporation with a date": {give: (&kenall.Corporation{CloseDate: kenall.Date{Time: time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC), Valid: true}}).IsClosed(), want: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if c.give != c.want {
+				t.Errorf("give: %v, want: %v", c.give, c.want)
+			}
+		})
+	}
+}
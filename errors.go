@@ -1,13 +1,27 @@
 package kenall
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
 )
 
+// maxErrorBodySize is the maximum size of the response body kept in kenall.APIError.
+const maxErrorBodySize = 4 << 10
+
 var (
 	// ErrInvalidArgument is an error value that will be returned if the value of the argument is invalid.
 	ErrInvalidArgument = errors.New("kenall: invalid argument")
+	// ErrInvalidCheckDigit is an error value that will be returned if the check digit of the corporate number is wrong.
+	// It also matches ErrInvalidArgument.
+	//nolint: gochecknoglobals
+	ErrInvalidCheckDigit = fmt.Errorf("%w: wrong check digit of the corporate number", ErrInvalidArgument)
+	// ErrBadRequest is an error value that will be returned if the request parameter is rejected by the kenall service.
+	ErrBadRequest = errors.New("kenall: 400 bad request error")
 	// ErrUnauthorized is an error value that will be returned if the authorization token is invalid.
 	ErrUnauthorized = errors.New("kenall: 401 unauthorized error")
 	// ErrPaymentRequired is an error value that will be returned if the payment for your kenall account is overdue.
@@ -18,8 +32,102 @@ var (
 	ErrNotFound = errors.New("kenall: 404 not found error")
 	// ErrMethodNotAllowed is an error value that will be returned when the request calls a method that is not allowed.
 	ErrMethodNotAllowed = errors.New("kenall: 405 method not allowed error")
+	// ErrTooManyRequests is an error value that will be returned when the requests exceed the limit of the kenall service.
+	ErrTooManyRequests = errors.New("kenall: 429 too many requests error")
 	// ErrInternalServerError is an error value that will be returned when some error occurs in the kenall service.
 	ErrInternalServerError = errors.New("kenall: 500 internal server error")
+	// ErrServiceUnavailable is an error value that will be returned when the kenall service is temporarily unavailable.
+	ErrServiceUnavailable = errors.New("kenall: 503 service unavailable error")
+	// ErrRateLimited is an error value that will be returned when the request exceeds the rate limit of kenall.Client.
+	ErrRateLimited = errors.New("kenall: client-side rate limit exceeded")
 	// ErrTimeout is an error value that will be returned when the request is timeout.
 	ErrTimeout = func(err error) error { return fmt.Errorf("kenall: request timeout: %w", err) } //nolint: gochecknoglobals
 )
+
+// An APIError is an error value that will be returned when the kenall service responds with an error status.
+// It wraps the error value that corresponds to the status code, such as ErrNotFound, if any.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Message is the error message decoded from the response body, if any.
+	Message string
+	// Body is the raw response body, truncated to 4KiB.
+	Body []byte
+	// Header is the header of the response.
+	Header http.Header
+	// URL is the URL of the request.
+	URL string
+	// RetryAfter is the duration to wait before the next request given by the Retry-After header,
+	// which is set only for 429 Too Many Requests and 503 Service Unavailable.
+	RetryAfter time.Duration
+
+	err error
+}
+
+func newAPIError(req *http.Request, resp *http.Response, err error) *APIError {
+	//nolint: errcheck
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	var msg struct {
+		Message string `json:"message"`
+	}
+	//nolint: errcheck
+	_ = json.Unmarshal(body, &msg)
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    msg.Message,
+		Body:       body,
+		Header:     resp.Header,
+		URL:        req.URL.String(),
+		RetryAfter: 0,
+		err:        err,
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		apiErr.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	}
+
+	return apiErr
+}
+
+// parseRetryAfter parses the value of the Retry-After header given in either delay-seconds or HTTP-date.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+
+	if sec, err := strconv.Atoi(v); err == nil {
+		return max(0, time.Duration(sec)*time.Second)
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(0, t.Sub(now))
+	}
+
+	return 0
+}
+
+// Error implements error interface.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("kenall: not registered in the error handling, http status code = %d", e.StatusCode)
+	if e.err != nil {
+		msg = e.err.Error()
+	}
+
+	if e.Message != "" {
+		msg += ", message = " + e.Message
+	}
+
+	return msg
+}
+
+// RequestID returns the request ID given by the X-Request-Id header of the response, if any.
+func (e *APIError) RequestID() string {
+	return e.Header.Get("X-Request-Id")
+}
+
+// Unwrap returns the error value that corresponds to the status code.
+func (e *APIError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,101 @@
+package kenall_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestAPIError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "request-"+r.URL.Path[len("/cities/"):])
+
+		switch r.URL.Path {
+		case "/cities/40":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"invalid prefecture code"}`))
+		case "/cities/41":
+			w.WriteHeader(http.StatusTeapot)
+			_, _ = w.Write([]byte(`{"message":"i'm a teapot"}`))
+		case "/cities/42":
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/cities/53":
+			w.Header().Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/cities/44":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"not found"}`))
+		case "/cities/50":
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(strings.Repeat("x", 8<<10)))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		givePrefectureCode string
+		wantStatusCode     int
+		wantSentinel       error
+		wantMessage        string
+		wantBodyLen        int
+		wantErrorMessage   string
+		wantRetryAfter     time.Duration
+	}{
+		"Bad request":           {givePrefectureCode: "40", wantStatusCode: http.StatusBadRequest, wantSentinel: kenall.ErrBadRequest, wantMessage: "invalid prefecture code", wantBodyLen: 37, wantErrorMessage: "kenall: 400 bad request error, message = invalid prefecture code", wantRetryAfter: 0},
+		"Unknown status code":   {givePrefectureCode: "41", wantStatusCode: http.StatusTeapot, wantSentinel: nil, wantMessage: "i'm a teapot", wantBodyLen: 26, wantErrorMessage: "kenall: not registered in the error handling, http status code = 418, message = i'm a teapot", wantRetryAfter: 0},
+		"Not found":             {givePrefectureCode: "44", wantStatusCode: http.StatusNotFound, wantSentinel: kenall.ErrNotFound, wantMessage: "not found", wantBodyLen: 23, wantErrorMessage: "kenall: 404 not found error, message = not found", wantRetryAfter: 0},
+		"Too many requests":     {givePrefectureCode: "42", wantStatusCode: http.StatusTooManyRequests, wantSentinel: kenall.ErrTooManyRequests, wantMessage: "", wantBodyLen: 0, wantErrorMessage: "kenall: 429 too many requests error", wantRetryAfter: 2 * time.Minute},
+		"Internal server error": {givePrefectureCode: "50", wantStatusCode: http.StatusInternalServerError, wantSentinel: kenall.ErrInternalServerError, wantMessage: "", wantBodyLen: 4 << 10, wantErrorMessage: "kenall: 500 internal server error", wantRetryAfter: 0},
+		"Service unavailable":   {givePrefectureCode: "53", wantStatusCode: http.StatusServiceUnavailable, wantSentinel: kenall.ErrServiceUnavailable, wantMessage: "", wantBodyLen: 0, wantErrorMessage: "kenall: 503 service unavailable error", wantRetryAfter: time.Hour},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = cli.GetCity(t.Context(), c.givePrefectureCode)
+			if c.wantSentinel != nil && !errors.Is(err, c.wantSentinel) {
+				t.Errorf("give: %v, want: %v", err, c.wantSentinel)
+			}
+
+			var apiErr *kenall.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("give: %v, want: %T", err, apiErr)
+			}
+			if apiErr.StatusCode != c.wantStatusCode {
+				t.Errorf("give: %v, want: %v", apiErr.StatusCode, c.wantStatusCode)
+			}
+			if apiErr.Message != c.wantMessage {
+				t.Errorf("give: %v, want: %v", apiErr.Message, c.wantMessage)
+			}
+			if len(apiErr.Body) != c.wantBodyLen {
+				t.Errorf("give: %v, want: %v", len(apiErr.Body), c.wantBodyLen)
+			}
+			if want := "request-" + c.givePrefectureCode; apiErr.RequestID() != want {
+				t.Errorf("give: %v, want: %v", apiErr.RequestID(), want)
+			}
+			if want := srv.URL + "/cities/" + c.givePrefectureCode; apiErr.URL != want {
+				t.Errorf("give: %v, want: %v", apiErr.URL, want)
+			}
+			if d := apiErr.RetryAfter - c.wantRetryAfter; d > 0 || d < -2*time.Second {
+				t.Errorf("give: %v, want: %v", apiErr.RetryAfter, c.wantRetryAfter)
+			}
+			if apiErr.Error() != c.wantErrorMessage {
+				t.Errorf("give: %v, want: %v", apiErr.Error(), c.wantErrorMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Package kenallotel adapts kenall.Tracer to OpenTelemetry.
+// It is a separate module so that kenall itself does not depend on OpenTelemetry.
+package kenallotel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nagisa-inc/go-kenall"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the tracer given to trace.TracerProvider.
+const InstrumentationName = "github.com/nagisa-inc/go-kenall/kenallotel"
+
+type (
+	// A Tracer is kenall.Tracer that starts a client span of OpenTelemetry for each API call.
+	Tracer struct {
+		tracer trace.Tracer
+	}
+	span struct {
+		span trace.Span
+	}
+)
+
+var (
+	_ kenall.Tracer = (*Tracer)(nil)
+	_ kenall.Span   = (*span)(nil)
+)
+
+// NewTracer creates kenallotel.Tracer with the provider, where the global provider is used if it is nil.
+func NewTracer(provider trace.TracerProvider) *Tracer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	return &Tracer{tracer: provider.Tracer(InstrumentationName)}
+}
+
+// Start implements kenall.Tracer interface.
+func (t *Tracer) Start(ctx context.Context, name string) (context.Context, kenall.Span) { //nolint: ireturn
+	ctx, s := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+
+	return ctx, &span{span: s}
+}
+
+// SetAttribute implements kenall.Span interface.
+func (s *span) SetAttribute(key string, value any) {
+	switch v := value.(type) {
+	case string:
+		s.span.SetAttributes(attribute.String(key, v))
+	case int:
+		s.span.SetAttributes(attribute.Int(key, v))
+	case bool:
+		s.span.SetAttributes(attribute.Bool(key, v))
+	default:
+		s.span.SetAttributes(attribute.String(key, fmt.Sprint(v)))
+	}
+}
+
+// RecordError implements kenall.Span interface.
+func (s *span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// End implements kenall.Span interface.
+func (s *span) End() {
+	s.span.End()
+}
@@ -0,0 +1,116 @@
+package kenallotel_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/nagisa-inc/go-kenall"
+	"github.com/nagisa-inc/go-kenall/kenallotel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer(t *testing.T) {
+	t.Parallel()
+
+	whoami, err := os.ReadFile("../testdata/whoami.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/whoami" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		if _, err := w.Write(whoami); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { _ = provider.Shutdown(t.Context()) })
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithTracer(kenallotel.NewTracer(provider)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetWhoami(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.GetCity(t.Context(), "13"); !errors.Is(err, kenall.ErrServiceUnavailable) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrServiceUnavailable)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("give: %v, want: %v", len(spans), 2)
+	}
+
+	cases := []struct {
+		wantName       string
+		wantAttributes []attribute.KeyValue
+		wantStatus     codes.Code
+		wantEvents     int
+	}{
+		{
+			wantName: "kenall.GetWhoami",
+			wantAttributes: []attribute.KeyValue{
+				attribute.String(kenall.AttributeHTTPMethod, http.MethodGet),
+				attribute.String(kenall.AttributeHTTPRoute, "/whoami"),
+				attribute.Int(kenall.AttributeHTTPStatusCode, http.StatusOK),
+				attribute.Int(kenall.AttributeRetryCount, 0),
+				attribute.Bool(kenall.AttributeCacheHit, false),
+			},
+			wantStatus: codes.Unset,
+			wantEvents: 0,
+		},
+		{
+			wantName: "kenall.GetCity",
+			wantAttributes: []attribute.KeyValue{
+				attribute.String(kenall.AttributeHTTPRoute, "/cities/{prefecture_code}"),
+				attribute.Int(kenall.AttributeHTTPStatusCode, http.StatusServiceUnavailable),
+			},
+			wantStatus: codes.Error,
+			wantEvents: 1,
+		},
+	}
+
+	for i, c := range cases {
+		span := spans[i]
+		if got := span.Name(); got != c.wantName {
+			t.Errorf("give: %v, want: %v", got, c.wantName)
+		}
+		if got := span.SpanKind(); got != trace.SpanKindClient {
+			t.Errorf("give: %v, want: %v", got, trace.SpanKindClient)
+		}
+
+		attrs := attribute.NewSet(span.Attributes()...)
+		for _, want := range c.wantAttributes {
+			if got, ok := attrs.Value(want.Key); !ok || got != want.Value {
+				t.Errorf("give: %v, want: %v", got.Emit(), want.Value.Emit())
+			}
+		}
+
+		if got := span.Status().Code; got != c.wantStatus {
+			t.Errorf("give: %v, want: %v", got, c.wantStatus)
+		}
+		if got := len(span.Events()); got != c.wantEvents {
+			t.Errorf("give: %v, want: %v", got, c.wantEvents)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Package kenallprom adapts kenall.Metrics to the collectors of the Prometheus client.
+// It is a separate module so that kenall itself does not depend on the Prometheus client.
+package kenallprom
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/nagisa-inc/go-kenall"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// A Metrics is kenall.Metrics that counts requests and observes latency with the collectors of the Prometheus client.
+type Metrics struct {
+	requests  *prometheus.CounterVec
+	durations *prometheus.HistogramVec
+}
+
+var (
+	// DefaultDurationBuckets are the upper bounds in seconds of the buckets of the latency histogram.
+	//nolint: gochecknoglobals, mnd
+	DefaultDurationBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+	_ kenall.Metrics = (*Metrics)(nil)
+)
+
+// NewMetrics creates kenallprom.Metrics and registers its collectors with the registerer,
+// where prometheus.DefaultRegisterer is used if it is nil,
+// and kenallprom.DefaultDurationBuckets is used if no buckets are given.
+func NewMetrics(registerer prometheus.Registerer, buckets ...float64) (*Metrics, error) {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	if len(buckets) == 0 {
+		buckets = DefaultDurationBuckets
+	}
+
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "kenall_requests_total",
+			Help: "The number of API calls to the kenall service.",
+		}, []string{"operation", "status_code", "error_class"}),
+		durations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "kenall_request_duration_seconds",
+			Help:    "The latency of API calls to the kenall service.",
+			Buckets: buckets,
+		}, []string{"operation"}),
+	}
+
+	for _, c := range []prometheus.Collector{m.requests, m.durations} {
+		if err := registerer.Register(c); err != nil {
+			return nil, fmt.Errorf("kenallprom: failed to register the collector: %w", err)
+		}
+	}
+
+	return m, nil
+}
+
+// ObserveRequest implements kenall.Metrics interface.
+func (m *Metrics) ObserveRequest(rm kenall.RequestMetric) {
+	m.requests.WithLabelValues(rm.Operation, strconv.Itoa(rm.StatusCode), rm.ErrorClass).Inc()
+	m.durations.WithLabelValues(rm.Operation).Observe(rm.Duration.Seconds())
+}
@@ -0,0 +1,86 @@
+package kenallprom_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/nagisa-inc/go-kenall"
+	"github.com/nagisa-inc/go-kenall/kenallprom"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	whoami, err := os.ReadFile("../testdata/whoami.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/whoami" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		if _, err := w.Write(whoami); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	reg := prometheus.NewPedanticRegistry()
+	metrics, err := kenallprom.NewMetrics(reg, 0.5, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithMetrics(metrics))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 2 {
+		if _, err := cli.GetWhoami(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := cli.GetCity(t.Context(), "13"); !errors.Is(err, kenall.ErrServiceUnavailable) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrServiceUnavailable)
+	}
+
+	want := `
+# HELP kenall_requests_total The number of API calls to the kenall service.
+# TYPE kenall_requests_total counter
+kenall_requests_total{error_class="none",operation="GetWhoami",status_code="200"} 2
+kenall_requests_total{error_class="service_unavailable",operation="GetCity",status_code="503"} 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "kenall_requests_total"); err != nil {
+		t.Error(err)
+	}
+
+	if got := testutil.CollectAndCount(reg, "kenall_request_duration_seconds"); got != 2 {
+		t.Errorf("give: %v, want: %v", got, 2)
+	}
+}
+
+func TestNewMetrics_AlreadyRegistered(t *testing.T) {
+	t.Parallel()
+
+	reg := prometheus.NewRegistry()
+	if _, err := kenallprom.NewMetrics(reg); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := kenallprom.NewMetrics(reg)
+	var are prometheus.AlreadyRegisteredError
+	if !errors.As(err, &are) {
+		t.Errorf("give: %v, want: %T", err, are)
+	}
+}
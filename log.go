@@ -0,0 +1,76 @@
+package kenall
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const redacted = "REDACTED"
+
+// logRequest records the request at the debug level, or at the warn level if the status code is not mapped to an error.
+func logRequest(logger *slog.Logger, req *http.Request, attempt, code int, resp *response, d time.Duration, err error) {
+	ctx := context.WithoutCancel(req.Context())
+
+	var (
+		apiErr *APIError
+		size   int
+		level  = slog.LevelDebug
+	)
+
+	switch {
+	case resp != nil:
+		size = len(resp.body)
+	case errors.As(err, &apiErr):
+		size = len(apiErr.Body)
+		if apiErr.Unwrap() == nil {
+			level = slog.LevelWarn
+		}
+	}
+
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	u := redactURL(req.URL)
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.String("url", u),
+		slog.Int("status", code),
+		slog.Duration("latency", d),
+		slog.Int("bytes", size),
+		slog.Int("attempt", attempt),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", strings.ReplaceAll(err.Error(), req.URL.String(), u)))
+	}
+
+	logger.LogAttrs(ctx, level, "kenall: request", attrs...)
+}
+
+// redactURL returns the URL whose query values, fragment and user information are redacted,
+// since the address given to GetNormalizeAddress and the free text given to SearchAddress may carry personal data.
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	for k, vs := range q {
+		for i := range vs {
+			vs[i] = redacted
+		}
+
+		q[k] = vs
+	}
+
+	r := *u
+	r.RawQuery = q.Encode()
+	r.Fragment = ""
+	r.RawFragment = ""
+	r.User = nil
+
+	return r.String()
+}
@@ -0,0 +1,118 @@
+package kenall_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_WithLogger(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/postalcode/":
+			if _, err := w.Write(searchAddressResponse); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		case "/cities/13":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusTeapot)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithLogger(logger),
+		kenall.WithRetryPolicy(kenall.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const address = "東京都港区六本木六丁目10番1号六本木ヒルズ森タワー18F"
+	if _, err := cli.GetNormalizeAddress(t.Context(), address); err != nil {
+		t.Fatal(err)
+	}
+	_, _ = cli.GetCity(t.Context(), "13")
+	_, _ = cli.GetCity(t.Context(), "01")
+
+	type record struct {
+		Level   string `json:"level"`
+		Msg     string `json:"msg"`
+		Method  string `json:"method"`
+		Path    string `json:"path"`
+		URL     string `json:"url"`
+		Status  int    `json:"status"`
+		Latency int64  `json:"latency"`
+		Bytes   int    `json:"bytes"`
+		Attempt int    `json:"attempt"`
+		Error   string `json:"error"`
+	}
+
+	want := []record{
+		{Level: "DEBUG", Msg: "kenall: request", Method: "GET", Path: "/postalcode/", URL: srv.URL + "/postalcode/?t=REDACTED", Status: 200, Bytes: len(searchAddressResponse), Attempt: 1},
+		{Level: "DEBUG", Msg: "kenall: request", Method: "GET", Path: "/cities/13", URL: srv.URL + "/cities/13", Status: 503, Bytes: 0, Attempt: 1, Error: "kenall: 503 service unavailable error"},
+		{Level: "DEBUG", Msg: "kenall: request", Method: "GET", Path: "/cities/13", URL: srv.URL + "/cities/13", Status: 503, Bytes: 0, Attempt: 2, Error: "kenall: 503 service unavailable error"},
+		{Level: "WARN", Msg: "kenall: request", Method: "GET", Path: "/cities/01", URL: srv.URL + "/cities/01", Status: 418, Bytes: 0, Attempt: 1, Error: "kenall: not registered in the error handling, http status code = 418"},
+	}
+
+	out := buf.String()
+	if strings.Contains(out, "opencollector") || strings.Contains(out, "六本木") {
+		t.Errorf("the log should not contain the token and the personal data, log = %s", out)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != len(want) {
+		t.Fatalf("give: %v, want: %v", len(lines), len(want))
+	}
+
+	for i, line := range lines {
+		var got record
+		if err := json.Unmarshal([]byte(line), &got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Latency <= 0 {
+			t.Errorf("give: %v, want: > 0", got.Latency)
+		}
+		got.Latency = 0
+		if got != want[i] {
+			t.Errorf("give: %+v, want: %+v", got, want[i])
+		}
+	}
+}
+
+func TestClient_WithLogger_TransportError(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint("http://127.0.0.1:0"), kenall.WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetNormalizeAddress(t.Context(), "東京都港区六本木六丁目10番1号六本木ヒルズ森タワー#1801&tel=09012345678"); err == nil {
+		t.Fatal("an error should not be nil")
+	}
+
+	out := buf.String()
+	for _, personal := range []string{"六本木", "%E5%85%AD", "1801", "tel", "09012345678"} {
+		if strings.Contains(out, personal) {
+			t.Errorf("the log should not contain the personal data, log = %s", out)
+		}
+	}
+	if !strings.Contains(out, `url="http://127.0.0.1:0/postalcode/?t=REDACTED"`) {
+		t.Errorf("the log should contain the redacted URL, log = %s", out)
+	}
+}
@@ -0,0 +1,103 @@
+package kenall
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"time"
+)
+
+// The error classes of kenall.RequestMetric.
+const (
+	ErrorClassNone                = "none"
+	ErrorClassBadRequest          = "bad_request"
+	ErrorClassUnauthorized        = "unauthorized"
+	ErrorClassPaymentRequired     = "payment_required"
+	ErrorClassForbidden           = "forbidden"
+	ErrorClassNotFound            = "not_found"
+	ErrorClassMethodNotAllowed    = "method_not_allowed"
+	ErrorClassTooManyRequests     = "too_many_requests"
+	ErrorClassInternalServerError = "internal_server_error"
+	ErrorClassServiceUnavailable  = "service_unavailable"
+	ErrorClassUnknownStatus       = "unknown_status"
+	ErrorClassTimeout             = "timeout"
+	ErrorClassCanceled            = "canceled"
+	ErrorClassRateLimited         = "rate_limited"
+	ErrorClassDecode              = "decode"
+	ErrorClassTransport           = "transport"
+)
+
+type (
+	// A Metrics observes each API call of kenall.Client. It must be safe for concurrent use.
+	// The kenallprom module adapts it to the collectors of the Prometheus client.
+	Metrics interface {
+		ObserveRequest(m RequestMetric)
+	}
+	// A RequestMetric is the result of an API call of kenall.Client.
+	RequestMetric struct {
+		// Operation is the method name of kenall.Client, e.g. "GetAddress".
+		Operation string
+		// StatusCode is the HTTP status code of the last response, or zero if no response is received.
+		StatusCode int
+		// ErrorClass is the class of the error given by kenall.ErrorClass.
+		ErrorClass string
+		// Duration is the duration of the API call including retries.
+		Duration time.Duration
+		// Attempts is the number of requests sent to the kenall service.
+		Attempts int
+		// CacheHit reports whether the response is served from kenall.Cache without requests.
+		CacheHit bool
+	}
+)
+
+// ErrorClass returns the class of the error returned by kenall.Client, which is ErrorClassNone for nil.
+func ErrorClass(err error) string { //nolint: cyclop
+	var (
+		apiErr  *APIError
+		netErr  net.Error
+		jsonErr *json.SyntaxError
+		typeErr *json.UnmarshalTypeError
+	)
+
+	switch {
+	case err == nil:
+		return ErrorClassNone
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, ErrRateLimited):
+		return ErrorClassRateLimited
+	case errors.As(err, &apiErr):
+		return apiErrorClass(apiErr)
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return ErrorClassTimeout
+	case errors.As(err, &jsonErr), errors.As(err, &typeErr), errors.Is(err, io.ErrUnexpectedEOF):
+		return ErrorClassDecode
+	default:
+		return ErrorClassTransport
+	}
+}
+
+func apiErrorClass(err *APIError) string {
+	for _, c := range [...]struct {
+		err   error
+		class string
+	}{
+		{err: ErrBadRequest, class: ErrorClassBadRequest},
+		{err: ErrUnauthorized, class: ErrorClassUnauthorized},
+		{err: ErrPaymentRequired, class: ErrorClassPaymentRequired},
+		{err: ErrForbidden, class: ErrorClassForbidden},
+		{err: ErrNotFound, class: ErrorClassNotFound},
+		{err: ErrMethodNotAllowed, class: ErrorClassMethodNotAllowed},
+		{err: ErrTooManyRequests, class: ErrorClassTooManyRequests},
+		{err: ErrInternalServerError, class: ErrorClassInternalServerError},
+		{err: ErrServiceUnavailable, class: ErrorClassServiceUnavailable},
+	} {
+		if errors.Is(err, c.err) {
+			return c.class
+		}
+	}
+
+	return ErrorClassUnknownStatus
+}
@@ -0,0 +1,96 @@
+package kenall_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+type recordingMetrics struct {
+	mu      sync.Mutex
+	metrics []kenall.RequestMetric
+}
+
+func (rm *recordingMetrics) ObserveRequest(m kenall.RequestMetric) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	rm.metrics = append(rm.metrics, m)
+}
+
+func TestErrorClass(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give error
+		want string
+	}{
+		"Nil":          {give: nil, want: kenall.ErrorClassNone},
+		"Canceled":     {give: fmt.Errorf("wrap: %w", context.Canceled), want: kenall.ErrorClassCanceled},
+		"Timeout":      {give: kenall.ErrTimeout(context.DeadlineExceeded), want: kenall.ErrorClassTimeout},
+		"Rate limited": {give: kenall.ErrRateLimited, want: kenall.ErrorClassRateLimited},
+		"Decode":       {give: fmt.Errorf("wrap: %w", json.Unmarshal([]byte("wrong"), &struct{}{})), want: kenall.ErrorClassDecode},
+		"Transport":    {give: errors.New("connection refused"), want: kenall.ErrorClassTransport},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := kenall.ErrorClass(c.give); got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestClient_WithMetrics(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	metrics := &recordingMetrics{}
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithMetrics(metrics))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, code := range []string{"13", "48", "91", "94"} {
+		_, _ = cli.GetCity(t.Context(), code)
+	}
+	if _, err := cli.GetAddress(t.Context(), "1008105"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		operation  string
+		statusCode int
+		errorClass string
+	}{
+		{operation: "GetCity", statusCode: http.StatusOK, errorClass: kenall.ErrorClassNone},
+		{operation: "GetCity", statusCode: http.StatusNotFound, errorClass: kenall.ErrorClassNotFound},
+		{operation: "GetCity", statusCode: http.StatusForbidden, errorClass: kenall.ErrorClassForbidden},
+		{operation: "GetCity", statusCode: http.StatusServiceUnavailable, errorClass: kenall.ErrorClassServiceUnavailable},
+		{operation: "GetAddress", statusCode: http.StatusOK, errorClass: kenall.ErrorClassNone},
+	}
+
+	if len(metrics.metrics) != len(want) {
+		t.Fatalf("give: %v, want: %v", len(metrics.metrics), len(want))
+	}
+
+	for i, m := range metrics.metrics {
+		if m.Operation != want[i].operation || m.StatusCode != want[i].statusCode || m.ErrorClass != want[i].errorClass {
+			t.Errorf("give: %+v, want: %+v", m, want[i])
+		}
+		if m.Attempts != 1 || m.CacheHit || m.Duration <= 0 {
+			t.Errorf("give: %+v, want: 1 attempt without cache", m)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package offline
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nagisa-inc/go-kenall"
+)
+
+const (
+	kenAllFields   = 15
+	jigyosyoFields = 13
+	unknownFloor   = "地階・階層不明"
+)
+
+// LoadKenAll loads KEN_ALL.CSV, the postal code dataset of towns, into the dataset.
+// r is read in Shift_JIS as Japan Post distributes it.
+// The records of a town split into multiple lines are merged into one.
+func (d *Dataset) LoadKenAll(r io.Reader) error {
+	var (
+		addrs   []*kenall.Address
+		pending []string
+	)
+
+	err := readCSV(r, kenAllFields, func(rec []string) {
+		if pending != nil && pending[2] == rec[2] {
+			if rec[5] != pending[5] && !strings.HasSuffix(pending[5], rec[5]) {
+				pending[5] += rec[5]
+			}
+
+			pending[8] += rec[8]
+			rec = pending
+		} else if pending != nil {
+			addrs = append(addrs, newTownAddress(pending))
+		}
+
+		pending = nil
+
+		if strings.Count(rec[8], "（") > strings.Count(rec[8], "）") {
+			pending = rec
+
+			return
+		}
+
+		addrs = append(addrs, newTownAddress(rec))
+	})
+	if err != nil {
+		return fmt.Errorf("offline: failed to load KEN_ALL.CSV: %w", err)
+	}
+
+	if pending != nil {
+		addrs = append(addrs, newTownAddress(pending))
+	}
+
+	splitBuildings(addrs)
+	d.add(addrs)
+
+	return nil
+}
+
+// LoadJigyosyo loads JIGYOSYO.CSV, the postal code dataset of large-volume business offices, into the dataset.
+// As with LoadKenAll, r is read in Shift_JIS.
+func (d *Dataset) LoadJigyosyo(r io.Reader) error {
+	var addrs []*kenall.Address
+
+	err := readCSV(r, jigyosyoFields, func(rec []string) {
+		addrs = append(addrs, newCorporationAddress(rec))
+	})
+	if err != nil {
+		return fmt.Errorf("offline: failed to load JIGYOSYO.CSV: %w", err)
+	}
+
+	d.add(addrs)
+
+	return nil
+}
+
+func readCSV(r io.Reader, fields int, fn func([]string)) error {
+	cr := csv.NewReader(newShiftJISReader(r))
+	cr.FieldsPerRecord = fields
+
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read a record: %w", err)
+		}
+
+		fn(rec)
+	}
+}
+
+// newTownAddress converts a record of KEN_ALL.CSV into kenall.Address.
+func newTownAddress(rec []string) *kenall.Address {
+	kanaRaw := toFullWidth(rec[5])
+	town, kana, floor := normalizeTown(rec[8], kanaRaw)
+
+	//nolint: exhaustruct
+	return &kenall.Address{
+		JISX0402:           rec[0],
+		OldCode:            strings.TrimSpace(rec[1]),
+		PostalCode:         rec[2],
+		PrefectureKana:     toFullWidth(rec[3]),
+		CityKana:           toFullWidth(rec[4]),
+		TownKana:           kana,
+		TownKanaRaw:        kanaRaw,
+		Prefecture:         rec[6],
+		City:               rec[7],
+		Town:               town,
+		Floor:              floor,
+		TownPartial:        rec[9] == "1",
+		TownAddressedKoaza: rec[10] == "1",
+		TownChome:          rec[11] == "1",
+		TownMulti:          rec[12] == "1",
+		TownRaw:            rec[8],
+	}
+}
+
+// newCorporationAddress converts a record of JIGYOSYO.CSV into kenall.Address.
+func newCorporationAddress(rec []string) *kenall.Address {
+	//nolint: exhaustruct
+	addr := &kenall.Address{
+		JISX0402:   rec[0],
+		OldCode:    strings.TrimSpace(rec[8]),
+		PostalCode: rec[7],
+		Prefecture: rec[3],
+		City:       rec[4],
+		Town:       rec[5],
+		TownRaw:    rec[5],
+	}
+
+	addr.Corporation.Name = rec[2]
+	addr.Corporation.NameKana = toFullWidth(rec[1])
+	addr.Corporation.BlockLot = rec[6]
+	addr.Corporation.PostOffice = rec[9]
+	addr.Corporation.CodeType = json.Number(rec[10])
+
+	return addr
+}
+
+// normalizeTown removes the annotations from the town name and returns the town, its kana and the floor.
+// The town is empty when the record covers the whole city, as the kenall service does.
+func normalizeTown(town, kana string) (string, string, string) {
+	if town == "以下に掲載がない場合" ||
+		strings.HasSuffix(town, "の次に番地がくる場合") ||
+		(strings.HasSuffix(town, "一円") && town != "一円") {
+		return "", "", ""
+	}
+
+	i := strings.Index(town, "（")
+	if i < 0 {
+		return town, kana, ""
+	}
+
+	var floor string
+	if note := townNote(town); strings.HasSuffix(note, "階") {
+		floor = note
+	}
+
+	if j := strings.Index(kana, "（"); j >= 0 {
+		kana = kana[:j]
+	}
+
+	return town[:i], kana, floor
+}
+
+// townNote returns the annotation in the parentheses at the end of the town name, if any.
+func townNote(town string) string {
+	i := strings.Index(town, "（")
+	if i < 0 || !strings.HasSuffix(town, "）") {
+		return ""
+	}
+
+	return strings.TrimSuffix(town[i+len("（"):], "）")
+}
+
+// isBuilding reports whether the record is for a floor of a building, e.g. "西新宿新宿アイランドタワー（１階）".
+func isBuilding(townRaw string) bool {
+	note := townNote(townRaw)
+
+	return strings.HasSuffix(note, "階") || note == unknownFloor
+}
+
+// splitBuildings splits the building name out of the town of the records for each floor of a building,
+// as the kenall service does, by the longest town of the other records in the same city that prefixes it.
+func splitBuildings(addrs []*kenall.Address) {
+	towns := make(map[string][]string)
+
+	for _, a := range addrs {
+		if a.Town != "" && !isBuilding(a.TownRaw) {
+			towns[a.JISX0402] = append(towns[a.JISX0402], a.Town)
+		}
+	}
+
+	for _, a := range addrs {
+		if !isBuilding(a.TownRaw) {
+			continue
+		}
+
+		var town string
+		for _, t := range towns[a.JISX0402] {
+			if len(t) > len(town) && len(t) < len(a.Town) && strings.HasPrefix(a.Town, t) {
+				town = t
+			}
+		}
+
+		if town != "" {
+			a.Town, a.Building = town, a.Town[len(town):]
+		}
+	}
+}
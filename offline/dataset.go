@@ -0,0 +1,88 @@
+// Package offline serves addresses from the postal code datasets published by Japan Post,
+// for environments that cannot reach the kenall service.
+package offline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nagisa-inc/go-kenall"
+)
+
+var (
+	// ErrInvalidEncoding is returned when the dataset is not encoded in Shift_JIS.
+	ErrInvalidEncoding = errors.New("offline: the dataset must be encoded in Shift_JIS")
+
+	_ kenall.AddressResolver = (*Dataset)(nil)
+)
+
+// A Dataset is an in-memory index of addresses by postal code.
+type Dataset struct {
+	mu        sync.RWMutex
+	version   kenall.Version
+	addresses map[string][]*kenall.Address
+}
+
+// NewDataset returns an empty kenall/offline.Dataset, the version is reported as the version of the responses.
+func NewDataset(version time.Time) *Dataset {
+	return &Dataset{
+		mu:        sync.RWMutex{},
+		version:   kenall.Version(version),
+		addresses: make(map[string][]*kenall.Address),
+	}
+}
+
+// Len returns the number of postal codes in the dataset.
+func (d *Dataset) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return len(d.addresses)
+}
+
+// GetAddress returns the addresses of the postal code in the same form as kenall.Client.GetAddress.
+// The postal code is normalized by kenall.ParsePostalCode, so "〒100-0001" is also accepted.
+func (d *Dataset) GetAddress(ctx context.Context, postalCode string) (*kenall.GetAddressResponse, error) {
+	code, err := kenall.ParsePostalCode(postalCode)
+	if err != nil {
+		return nil, fmt.Errorf("offline: failed to parse the postal code: %w", err)
+	}
+
+	postalCode = code.String()
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("offline: failed to get address: %w", err)
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	addrs, ok := d.addresses[postalCode]
+	if !ok {
+		return nil, fmt.Errorf("offline: %s is not in the dataset: %w", postalCode, kenall.ErrNotFound)
+	}
+
+	res := &kenall.GetAddressResponse{
+		Version:   d.version,
+		Addresses: make([]*kenall.Address, 0, len(addrs)),
+	}
+
+	for _, a := range addrs {
+		addr := *a
+		res.Addresses = append(res.Addresses, &addr)
+	}
+
+	return res, nil
+}
+
+func (d *Dataset) add(addrs []*kenall.Address) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, a := range addrs {
+		d.addresses[a.PostalCode] = append(d.addresses[a.PostalCode], a)
+	}
+}
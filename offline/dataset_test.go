@@ -0,0 +1,223 @@
+package offline_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nagisa-inc/go-kenall"
+	"github.com/nagisa-inc/go-kenall/offline"
+)
+
+func newDataset(t *testing.T) *offline.Dataset {
+	t.Helper()
+
+	d := offline.NewDataset(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+	for name, load := range map[string]func(f *os.File) error{
+		"testdata/ken_all.csv":  func(f *os.File) error { return d.LoadKenAll(f) },
+		"testdata/jigyosyo.csv": func(f *os.File) error { return d.LoadJigyosyo(f) },
+	} {
+		f, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = f.Close() })
+
+		if err := load(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return d
+}
+
+func TestDataset_GetAddress(t *testing.T) {
+	t.Parallel()
+
+	d := newDataset(t)
+	if d.Len() != 8 {
+		t.Errorf("give: %v, want: %v", d.Len(), 8)
+	}
+
+	cases := map[string]struct {
+		give      string
+		want      []string
+		wantError error
+	}{
+		"Whole city":            {give: "0600000", want: []string{""}, wantError: nil},
+		"Chome":                 {give: "0640941", want: []string{"旭ケ丘"}, wantError: nil},
+		"Multi-line town":       {give: "0660005", want: []string{"協和"}, wantError: nil},
+		"Multiple towns":        {give: "1000004", want: []string{"大手町", "丸の内"}, wantError: nil},
+		"Floor of a building":   {give: "1636001", want: []string{"西新宿"}, wantError: nil},
+		"Unknown floor":         {give: "1636090", want: []string{"西新宿"}, wantError: nil},
+		"Business office":       {give: "1638001", want: []string{"西新宿"}, wantError: nil},
+		"Not found":             {give: "9999999", want: nil, wantError: kenall.ErrNotFound},
+		"Hyphenated":            {give: "〒100-0004", want: []string{"大手町", "丸の内"}, wantError: nil},
+		"Invalid postal code":   {give: "100-00004", want: nil, wantError: kenall.ErrInvalidArgument},
+		"Too short postal code": {give: "100000", want: nil, wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := d.GetAddress(t.Context(), c.give)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if c.wantError != nil {
+				return
+			}
+
+			if time.Time(res.Version).Format(kenall.RFC3339DateFormat) != "2024-01-31" {
+				t.Errorf("give: %v, want: %v", time.Time(res.Version), "2024-01-31")
+			}
+			towns := make([]string, 0, len(res.Addresses))
+			for _, a := range res.Addresses {
+				towns = append(towns, a.Town)
+			}
+			if strings.Join(towns, ",") != strings.Join(c.want, ",") {
+				t.Errorf("give: %v, want: %v", towns, c.want)
+			}
+		})
+	}
+}
+
+func TestDataset_LoadKenAll(t *testing.T) {
+	t.Parallel()
+
+	d := newDataset(t)
+
+	res, err := d.GetAddress(t.Context(), "0660005")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := res.Addresses[0]
+	if want := "協和（８８－２、２７１－１０、３４３－２、４０４－１、４２７－３、" +
+		"４３１－１２、４４３－６、６０８－２、６４１－８、８１４、８４２－５、１１３７－３、１３９２、１６５７、１７５２番地）"; a.TownRaw != want {
+		t.Errorf("give: %v, want: %v", a.TownRaw, want)
+	}
+	if want := "キョウワ（８８－２、２７１－１０、３４３－２、４０４－１、４２７－３、" +
+		"４３１－１２、４４３－６、６０８－２、６４１－８、８１４、８４２－５、１１３７－３、１３９２、１６５７、１７５２バンチ）"; a.TownKanaRaw != want {
+		t.Errorf("give: %v, want: %v", a.TownKanaRaw, want)
+	}
+	if a.TownKana != "キョウワ" || a.PrefectureKana != "ホッカイドウ" || a.CityKana != "チトセシ" {
+		t.Errorf("give: %v, want: %v", []string{a.TownKana, a.PrefectureKana, a.CityKana}, "キョウワ, ホッカイドウ, チトセシ")
+	}
+	if !a.TownPartial || a.TownChome || a.TownMulti || a.TownAddressedKoaza {
+		t.Errorf("give: %v, want: %v", a, "only TownPartial")
+	}
+	if a.JISX0402 != "01224" || a.OldCode != "066" || a.Prefecture != "北海道" || a.City != "千歳市" {
+		t.Errorf("give: %v, want: %v", a, "01224, 066, 北海道, 千歳市")
+	}
+
+	res, err = d.GetAddress(t.Context(), "1000004")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := res.Addresses[1]; !a.TownChome || !a.TownMulti || a.TownKana != "マルノウチ" {
+		t.Errorf("give: %v, want: %v", a, "TownChome, TownMulti and マルノウチ")
+	}
+
+	res, err = d.GetAddress(t.Context(), "1636001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := res.Addresses[0]; a.Building != "新宿アイランドタワー" || a.Floor != "１階" ||
+		a.TownKana != "ニシシンジュクシンジュクアイランドタワー" || a.TownRaw != "西新宿新宿アイランドタワー（１階）" {
+		t.Errorf("give: %v, want: %v", a, "新宿アイランドタワー, １階 and ニシシンジュクシンジュクアイランドタワー")
+	}
+
+	res, err = d.GetAddress(t.Context(), "1636090")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := res.Addresses[0]; a.Building != "新宿アイランドタワー" || a.Floor != "" {
+		t.Errorf("give: %v, want: %v", a, "新宿アイランドタワー without the floor")
+	}
+
+	res, err = d.GetAddress(t.Context(), "1600023")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := res.Addresses[0]; a.Town != "西新宿" || a.Building != "" || a.TownKana != "ニシシンジュク" {
+		t.Errorf("give: %v, want: %v", a, "西新宿 without the building")
+	}
+
+	res, err = d.GetAddress(t.Context(), "0600000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := res.Addresses[0]; a.TownKana != "" || a.TownRaw != "以下に掲載がない場合" {
+		t.Errorf("give: %v, want: %v", a, "an empty town")
+	}
+}
+
+func TestDataset_LoadJigyosyo(t *testing.T) {
+	t.Parallel()
+
+	d := newDataset(t)
+
+	res, err := d.GetAddress(t.Context(), "1638001")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := res.Addresses[0]
+	if a.Corporation.Name != "東京都庁" || a.Corporation.NameKana != "トウキヨウトチヨウ" ||
+		a.Corporation.BlockLot != "２丁目８－１" || a.Corporation.PostOffice != "新宿" ||
+		a.Corporation.CodeType.String() != "0" || a.Corporation.BlockLotNum.Valid {
+		t.Errorf("give: %v, want: %v", a.Corporation, "東京都庁")
+	}
+	if a.JISX0402 != "13104" || a.OldCode != "160" || a.Town != "西新宿" {
+		t.Errorf("give: %v, want: %v", a, "13104, 160, 西新宿")
+	}
+
+	// The returned address must not modify the dataset.
+	a.Town = "modified"
+	if res, _ := d.GetAddress(t.Context(), "1638001"); res.Addresses[0].Town != "西新宿" {
+		t.Errorf("give: %v, want: %v", res.Addresses[0].Town, "西新宿")
+	}
+}
+
+func TestDataset_LoadErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		wantError error
+	}{
+		"Unmapped character":     {give: "13104,\"\x85\x40\",\"\",\"\",\"\",\"\",\"\",\"1638001\",\"160  \",\"\",0,0,0\n", wantError: offline.ErrInvalidEncoding},
+		"Undefined byte":         {give: "13104,\"\xa0\",\"\",\"\",\"\",\"\",\"\",\"1638001\",\"160  \",\"\",0,0,0\n", wantError: offline.ErrInvalidEncoding},
+		"Truncated character":    {give: "13104,\"\",\"\",\"\",\"\",\"\",\"\",\"1638001\",\"160  \",\"\",0,0,\x93", wantError: offline.ErrInvalidEncoding},
+		"Wrong number of fields": {give: "13104,\"1638001\"\n", wantError: nil},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := offline.NewDataset(time.Time{}).LoadJigyosyo(strings.NewReader(c.give))
+			if err == nil {
+				t.Fatalf("give: %v, want: %v", err, "an error")
+			}
+			if c.wantError != nil && !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+		})
+	}
+}
+
+func TestDataset_GetAddressCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	if _, err := newDataset(t).GetAddress(ctx, "1638001"); !errors.Is(err, context.Canceled) {
+		t.Errorf("give: %v, want: %v", err, context.Canceled)
+	}
+}
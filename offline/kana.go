@@ -0,0 +1,63 @@
+package offline
+
+import "strings"
+
+const (
+	halfWidthKatakanaFirst = '｡'
+	halfWidthKatakanaLast  = 'ﾟ'
+	halfWidthVoicedMark    = 'ﾞ'
+	halfWidthSemiVoiced    = 'ﾟ'
+	fullWidthOffset        = '！' - '!'
+)
+
+var (
+	//nolint: gochecknoglobals
+	fullWidthKatakana = []rune("。「」、・ヲァィゥェォャュョッーアイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン゛゜")
+)
+
+// toFullWidth converts the half-width katakana and ASCII characters used by the datasets into full-width ones.
+func toFullWidth(s string) string {
+	var b strings.Builder
+
+	rs := []rune(s)
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+
+		switch {
+		case r >= '!' && r <= '~':
+			b.WriteRune(r + fullWidthOffset)
+		case r >= halfWidthKatakanaFirst && r <= halfWidthKatakanaLast:
+			fw := fullWidthKatakana[r-halfWidthKatakanaFirst]
+			if i+1 < len(rs) {
+				if v, ok := voiced(fw, rs[i+1]); ok {
+					fw = v
+					i++
+				}
+			}
+
+			b.WriteRune(fw)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// voiced combines the katakana with the following (semi-)voiced sound mark.
+func voiced(r, mark rune) (rune, bool) {
+	switch {
+	case mark == halfWidthVoicedMark && r == 'ウ':
+		return 'ヴ', true
+	case mark == halfWidthVoicedMark && (r >= 'カ' && r <= 'ト' || r >= 'ハ' && r <= 'ホ'):
+		if r == 'ッ' {
+			return r, false
+		}
+
+		return r + 1, true
+	case mark == halfWidthSemiVoiced && r >= 'ハ' && r <= 'ホ':
+		return r + 2, true
+	default:
+		return r, false
+	}
+}
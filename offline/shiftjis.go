@@ -0,0 +1,110 @@
+package offline
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+const (
+	halfWidthKatakanaLead = 0xA1
+	halfWidthKatakanaTail = 0xDF
+	shiftJISTrailOffset   = 0x40
+	shiftJISTrailSkip     = 0x7F
+	shiftJISRowSize       = 188
+)
+
+// A shiftJISReader decodes Shift_JIS, more precisely Windows-31J as the datasets are encoded in, into UTF-8.
+type shiftJISReader struct {
+	r   *bufio.Reader
+	buf []byte
+	err error
+}
+
+func newShiftJISReader(r io.Reader) *shiftJISReader {
+	return &shiftJISReader{r: bufio.NewReader(r), buf: nil, err: nil}
+}
+
+// Read implements io.Reader interface.
+func (sr *shiftJISReader) Read(p []byte) (int, error) {
+	for len(sr.buf) < len(p) && sr.err == nil {
+		sr.err = sr.decode()
+	}
+
+	n := copy(p, sr.buf)
+	sr.buf = sr.buf[n:]
+
+	if len(sr.buf) == 0 && sr.err != nil {
+		return n, sr.err
+	}
+
+	return n, nil
+}
+
+// decode decodes a character into buf.
+func (sr *shiftJISReader) decode() error {
+	lead, err := sr.r.ReadByte()
+	if err != nil {
+		return err //nolint: wrapcheck
+	}
+
+	switch {
+	case lead < utf8.RuneSelf:
+		sr.buf = append(sr.buf, lead)
+
+		return nil
+	case lead >= halfWidthKatakanaLead && lead <= halfWidthKatakanaTail:
+		sr.buf = utf8.AppendRune(sr.buf, '｡'+rune(lead-halfWidthKatakanaLead))
+
+		return nil
+	}
+
+	trail, err := sr.r.ReadByte()
+	if errors.Is(err, io.EOF) {
+		return ErrInvalidEncoding
+	}
+
+	if err != nil {
+		return err //nolint: wrapcheck
+	}
+
+	r, ok := shiftJISRune(lead, trail)
+	if !ok {
+		return ErrInvalidEncoding
+	}
+
+	sr.buf = utf8.AppendRune(sr.buf, r)
+
+	return nil
+}
+
+// shiftJISRune returns the code point of the double-byte character.
+func shiftJISRune(lead, trail byte) (rune, bool) { //nolint: mnd
+	var row int
+
+	switch {
+	case lead >= 0x81 && lead <= 0x9F:
+		row = int(lead) - 0x81
+	case lead >= 0xE0 && lead <= 0xFC:
+		row = int(lead) - 0xC1
+	default:
+		return 0, false
+	}
+
+	if trail < shiftJISTrailOffset || trail == shiftJISTrailSkip || trail > 0xFC {
+		return 0, false
+	}
+
+	col := int(trail) - shiftJISTrailOffset
+	if trail > shiftJISTrailSkip {
+		col--
+	}
+
+	ptr := row*shiftJISRowSize + col
+	if ptr >= len(shiftJISTable) || shiftJISTable[ptr] == 0 {
+		return 0, false
+	}
+
+	return rune(shiftJISTable[ptr]), true
+}
@@ -1,6 +1,10 @@
 package kenall
 
-import "net/http"
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
 
 type (
 	withHTTPClient struct {
@@ -9,6 +13,36 @@ type (
 	withEndpoint struct {
 		endpoint string
 	}
+	withRetryPolicy struct {
+		policy RetryPolicy
+	}
+	withRateLimit struct {
+		limit RateLimit
+	}
+	withCache struct {
+		cache Cache
+	}
+	withCacheTTL struct {
+		ttl time.Duration
+	}
+	withMiddleware struct {
+		middlewares []Middleware
+	}
+	withTracer struct {
+		tracer Tracer
+	}
+	withMetrics struct {
+		metrics Metrics
+	}
+	withLogger struct {
+		logger *slog.Logger
+	}
+	withTokenSource struct {
+		src                   TokenSource
+		refreshOnUnauthorized bool
+	}
+	withRequestCoalescing struct{}
+	withLenientPostalCode struct{}
 )
 
 // Apply implements kenall.ClientOption interface.
@@ -21,6 +55,75 @@ func (w *withEndpoint) Apply(cli *Client) {
 	cli.Endpoint = w.endpoint
 }
 
+// Apply implements kenall.ClientOption interface.
+func (w *withRetryPolicy) Apply(cli *Client) {
+	policy := w.policy
+	cli.retryPolicy = &policy
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withRateLimit) Apply(cli *Client) {
+	if w.limit.Rate <= 0 {
+		cli.rateLimiter = nil
+
+		return
+	}
+
+	cli.rateLimiter = newRateLimiter(w.limit)
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withCache) Apply(cli *Client) {
+	cli.cache = w.cache
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withCacheTTL) Apply(cli *Client) {
+	cli.cacheTTL = w.ttl
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withMiddleware) Apply(cli *Client) {
+	cli.middlewares = append(cli.middlewares, w.middlewares...)
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withTracer) Apply(cli *Client) {
+	cli.tracer = w.tracer
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withMetrics) Apply(cli *Client) {
+	cli.metrics = w.metrics
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withLogger) Apply(cli *Client) {
+	cli.logger = w.logger
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withTokenSource) Apply(cli *Client) {
+	if w.src == nil {
+		cli.tokenSource = nil
+
+		return
+	}
+
+	//nolint: exhaustruct
+	cli.tokenSource = &cachedTokenSource{src: w.src, refreshOnUnauthorized: w.refreshOnUnauthorized}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withRequestCoalescing) Apply(cli *Client) {
+	cli.coalescer = newCoalescer()
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withLenientPostalCode) Apply(cli *Client) {
+	cli.lenient = true
+}
+
 // WithHTTPClient injects optional HTTP Client to kenall.Client.
 func WithHTTPClient(cli *http.Client) ClientOption {
 	return &withHTTPClient{client: cli}
@@ -30,3 +133,70 @@ func WithHTTPClient(cli *http.Client) ClientOption {
 func WithEndpoint(endpoint string) ClientOption {
 	return &withEndpoint{endpoint: endpoint}
 }
+
+// WithRetryPolicy injects optional retry policy to kenall.Client.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return &withRetryPolicy{policy: policy}
+}
+
+// WithRateLimit injects optional client-side rate limit to kenall.Client.
+// A non-positive rate disables the limit.
+func WithRateLimit(limit RateLimit) ClientOption {
+	return &withRateLimit{limit: limit}
+}
+
+// WithCache injects optional cache of the reference data, such as addresses, cities, corporations,
+// holidays and banks, to kenall.Client.
+func WithCache(cache Cache) ClientOption {
+	return &withCache{cache: cache}
+}
+
+// WithCacheTTL injects optional duration that responses are kept in kenall.Cache to kenall.Client.
+// The default is kenall.DefaultCacheTTL.
+func WithCacheTTL(ttl time.Duration) ClientOption {
+	return &withCacheTTL{ttl: ttl}
+}
+
+// WithMiddleware injects optional middlewares wrapping the transport of HTTPClient to kenall.Client.
+// It can be given more than once, and requests pass through the middlewares in the order given.
+// The Authorization header is set before the first middleware, so every middleware sees it
+// and should redact it when the request is logged.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return &withMiddleware{middlewares: middlewares}
+}
+
+// WithTracer injects optional tracer that starts a span for each API call to kenall.Client.
+func WithTracer(tracer Tracer) ClientOption {
+	return &withTracer{tracer: tracer}
+}
+
+// WithMetrics injects optional metrics that observe each API call to kenall.Client.
+func WithMetrics(metrics Metrics) ClientOption {
+	return &withMetrics{metrics: metrics}
+}
+
+// WithLogger injects optional logger that records each request to kenall.Client.
+// The Authorization header and the personal data in the query string are never logged.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return &withLogger{logger: logger}
+}
+
+// WithTokenSource injects optional token source that replaces the static token to kenall.Client.
+// The token is cached until its expiry, and if refreshOnUnauthorized is true,
+// the request is retried once with a new token when the kenall service responds with 401 Unauthorized.
+func WithTokenSource(src TokenSource, refreshOnUnauthorized bool) ClientOption {
+	return &withTokenSource{src: src, refreshOnUnauthorized: refreshOnUnauthorized}
+}
+
+// WithRequestCoalescing enables kenall.Client to collapse concurrent identical requests into one in-flight request.
+// The response body is shared and decoded for each caller, and the cancellation of a caller only detaches the caller
+// from the shared request, which is canceled when all callers leave.
+func WithRequestCoalescing() ClientOption {
+	return &withRequestCoalescing{}
+}
+
+// WithLenientPostalCode makes kenall.Client accept the postal codes as users type them, e.g. "〒100-0001",
+// by normalizing them with ParsePostalCode.
+func WithLenientPostalCode() ClientOption {
+	return &withLenientPostalCode{}
+}
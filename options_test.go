@@ -23,3 +23,102 @@ func TestWithEndpoint(t *testing.T) {
 		t.Error("a return value should not be nil")
 	}
 }
+
+func TestWithRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithRetryPolicy(kenall.RetryPolicy{})
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithRateLimit(kenall.RateLimit{})
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithCache(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithCache(nil)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithCacheTTL(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithCacheTTL(0)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithMiddleware()
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithTracer(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithTracer(nil)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithMetrics(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithMetrics(nil)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithLogger(nil)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithTokenSource(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithTokenSource(nil, false)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithRequestCoalescing(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithRequestCoalescing()
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithLenientPostalCode(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithLenientPostalCode()
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
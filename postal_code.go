@@ -0,0 +1,71 @@
+package kenall
+
+import (
+	"strings"
+	"unicode"
+)
+
+// postalCodeLength is the number of digits of a postal code.
+const postalCodeLength = 7
+
+// A PostalCode is a normalized postal code of 7 ASCII digits.
+type PostalCode string
+
+// ParsePostalCode parses the postal code as users type it, e.g. "100-0001", "〒100-0001", "１０００００１" or "100 0001".
+// It accepts full-width digits, the postal mark, the dash characters and whitespace between the 3rd and 4th digits,
+// and returns ErrInvalidArgument for the others.
+func ParsePostalCode(s string) (PostalCode, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSpace(strings.TrimPrefix(s, "〒"))
+
+	digits := make([]byte, 0, postalCodeLength)
+	separated := false
+
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, byte(r))
+		case r >= '０' && r <= '９':
+			digits = append(digits, byte(r-'０'+'0'))
+		case isPostalCodeSeparator(r) && len(digits) == 3 && !separated:
+			separated = true
+		case unicode.IsSpace(r) && len(digits) == 3:
+			// whitespace may surround the separator
+		default:
+			return "", ErrInvalidArgument
+		}
+
+		if len(digits) > postalCodeLength {
+			return "", ErrInvalidArgument
+		}
+	}
+
+	if len(digits) != postalCodeLength {
+		return "", ErrInvalidArgument
+	}
+
+	return PostalCode(digits), nil
+}
+
+// String implements fmt.Stringer interface, and returns 7 digits, e.g. "1000001".
+func (p PostalCode) String() string {
+	return string(p)
+}
+
+// Hyphenated returns the postal code separated by a hyphen, e.g. "100-0001".
+func (p PostalCode) Hyphenated() string {
+	if len(p) != postalCodeLength {
+		return string(p)
+	}
+
+	return string(p[:3]) + "-" + string(p[3:])
+}
+
+func isPostalCodeSeparator(r rune) bool {
+	switch r {
+	case '-', 'ー', '－', '‐', '‑', '–', '—', '―', '−', 'ｰ':
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,99 @@
+package kenall_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestParsePostalCode(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		want      string
+		wantError error
+	}{
+		"Digits":            {give: "1000001", want: "1000001", wantError: nil},
+		"Hyphen":            {give: "100-0001", want: "1000001", wantError: nil},
+		"Postal mark":       {give: "〒100-0001", want: "1000001", wantError: nil},
+		"Postal mark space": {give: "〒 100-0001", want: "1000001", wantError: nil},
+		"Full-width":        {give: "１０００００１", want: "1000001", wantError: nil},
+		"Full-width dash":   {give: "１００－０００１", want: "1000001", wantError: nil},
+		"Prolonged mark":    {give: "100ー0001", want: "1000001", wantError: nil},
+		"Unicode hyphen":    {give: "100‐0001", want: "1000001", wantError: nil},
+		"Space":             {give: " 100 0001\n", want: "1000001", wantError: nil},
+		"Ideographic space": {give: "100　0001", want: "1000001", wantError: nil},
+		"Spaced hyphen":     {give: "100 - 0001", want: "1000001", wantError: nil},
+		"Sign":              {give: "+100001", want: "", wantError: kenall.ErrInvalidArgument},
+		"Misplaced hyphen":  {give: "1000-001", want: "", wantError: kenall.ErrInvalidArgument},
+		"Double hyphen":     {give: "100--0001", want: "", wantError: kenall.ErrInvalidArgument},
+		"Too short":         {give: "100-001", want: "", wantError: kenall.ErrInvalidArgument},
+		"Too long":          {give: "100-00011", want: "", wantError: kenall.ErrInvalidArgument},
+		"Alphabet":          {give: "abc-defg", want: "", wantError: kenall.ErrInvalidArgument},
+		"Empty":             {give: "", want: "", wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := kenall.ParsePostalCode(c.give)
+			if !errors.Is(err, c.wantError) || (c.wantError == nil && err != nil) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if got.String() != c.want {
+				t.Errorf("give: %v, want: %v", got.String(), c.want)
+			}
+		})
+	}
+}
+
+func TestPostalCode_Hyphenated(t *testing.T) {
+	t.Parallel()
+
+	p, err := kenall.ParsePostalCode("〒１０００００１")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Hyphenated() != "100-0001" {
+		t.Errorf("give: %v, want: %v", p.Hyphenated(), "100-0001")
+	}
+	if got := kenall.PostalCode("123").Hyphenated(); got != "123" {
+		t.Errorf("give: %v, want: %v", got, "123")
+	}
+}
+
+func TestClient_GetAddressLenient(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	strict, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lenient, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithLenientPostalCode())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := strict.GetAddress(t.Context(), "〒100-8105"); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+
+	res, err := lenient.GetAddress(t.Context(), "〒100-8105")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Addresses[0].JISX0402 != "13104" {
+		t.Errorf("give: %v, want: %v", res.Addresses[0].JISX0402, "13104")
+	}
+
+	if _, err := lenient.GetAddress(t.Context(), "+100810"); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+}
@@ -0,0 +1,102 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// A RateLimit is a client-side limit of requests to the kenall service with a token bucket,
+// which is shared by all methods of the kenall.Client.
+// Every attempt of RetryPolicy takes a token, and waiting for it counts toward RetryPolicy.PerAttemptTimeout.
+type RateLimit struct {
+	// Rate is the number of requests allowed per second.
+	Rate float64
+	// Burst is the maximum number of requests allowed at once. The default is 1.
+	Burst int
+	// FailFast makes a request fail with ErrRateLimited instead of waiting for the next token.
+	FailFast bool
+}
+
+type rateLimiter struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	failFast bool
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	//nolint: exhaustruct
+	return &rateLimiter{
+		rate:     limit.Rate,
+		burst:    burst,
+		tokens:   burst,
+		failFast: limit.FailFast,
+	}
+}
+
+// wait takes a token from the bucket, waiting for the next token unless it is in fail-fast mode.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	d, ok := l.reserve(time.Now())
+	if !ok {
+		return ErrRateLimited
+	}
+
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+
+		return fmt.Errorf("kenall: failed to wait for the rate limit: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token and returns the duration until the token becomes available.
+func (l *rateLimiter) reserve(now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+
+		return 0, true
+	}
+
+	if l.failFast {
+		return 0, false
+	}
+
+	l.tokens--
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second)), true
+}
+
+// cancel gives back the token taken by reserve.
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = min(l.burst, l.tokens+1)
+}
@@ -0,0 +1,111 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		giveLimit    kenall.RateLimit
+		giveRequests int
+		wantMinTime  time.Duration
+		wantLimited  int
+	}{
+		"Disabled":  {giveLimit: kenall.RateLimit{Rate: 0}, giveRequests: 5, wantMinTime: 0, wantLimited: 0},
+		"Blocking":  {giveLimit: kenall.RateLimit{Rate: 20, Burst: 2}, giveRequests: 5, wantMinTime: 150 * time.Millisecond, wantLimited: 0},
+		"Fail fast": {giveLimit: kenall.RateLimit{Rate: 1, Burst: 2, FailFast: true}, giveRequests: 5, wantMinTime: 0, wantLimited: 3},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var hits atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				hits.Add(1)
+				if _, err := w.Write(whoamiResponse); err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithRateLimit(c.giveLimit))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var (
+				wg      sync.WaitGroup
+				limited atomic.Int32
+			)
+			start := time.Now()
+			for range c.giveRequests {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					_, err := cli.GetWhoami(t.Context())
+					switch {
+					case errors.Is(err, kenall.ErrRateLimited):
+						limited.Add(1)
+					case err != nil:
+						t.Error(err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if elapsed := time.Since(start); elapsed < c.wantMinTime {
+				t.Errorf("give: %v, want: >= %v", elapsed, c.wantMinTime)
+			}
+			if got := int(limited.Load()); got != c.wantLimited {
+				t.Errorf("give: %v, want: %v", got, c.wantLimited)
+			}
+			if got := int(hits.Load()); got != c.giveRequests-c.wantLimited {
+				t.Errorf("give: %v, want: %v", got, c.giveRequests-c.wantLimited)
+			}
+		})
+	}
+}
+
+func TestRateLimit_CanceledContext(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if _, err := w.Write(whoamiResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithRateLimit(kenall.RateLimit{Rate: 0.1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetWhoami(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	t.Cleanup(cancel)
+
+	start := time.Now()
+	if _, err := cli.GetWhoami(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("give: %v, want: %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("give: %v, want: < %v", elapsed, time.Second)
+	}
+}
@@ -0,0 +1,225 @@
+package kenall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// The sources of kenall.FallbackResolver.
+const (
+	// SourceRemote means the kenall service answered.
+	SourceRemote Source = iota
+	// SourceLocal means the local dataset answered.
+	SourceLocal
+)
+
+type (
+	// An AddressResolver resolves the addresses by postal code.
+	AddressResolver interface {
+		GetAddress(ctx context.Context, postalCode string) (*GetAddressResponse, error)
+	}
+	// A CityResolver resolves the cities by prefecture code.
+	CityResolver interface {
+		GetCity(ctx context.Context, prefectureCode string) (*GetCityResponse, error)
+	}
+	// A HolidayProvider provides the national holidays of Japan.
+	HolidayProvider interface {
+		GetHolidays(ctx context.Context) (*GetHolidaysResponse, error)
+	}
+	// A Resolver is the set of the resolvers that kenall.Client implements.
+	Resolver interface {
+		AddressResolver
+		CityResolver
+		HolidayProvider
+	}
+
+	// A Source is the source that answered to kenall.FallbackResolver.
+	Source int
+
+	// A FallbackResolver tries the remote resolver first, and falls back to the local ones
+	// when the remote one fails by ErrTimeout, a transport error, a 5xx status or ErrPaymentRequired.
+	// The local ones are called without the deadline of the caller if the remote one has used it up.
+	FallbackResolver struct {
+		remote  Resolver
+		address AddressResolver
+		city    CityResolver
+		holiday HolidayProvider
+	}
+	// A FallbackOption provides a customize option for kenall.FallbackResolver.
+	FallbackOption interface {
+		//nolint: inamedparam
+		Apply(*FallbackResolver)
+	}
+
+	withLocalAddressResolver struct {
+		resolver AddressResolver
+	}
+	withLocalCityResolver struct {
+		resolver CityResolver
+	}
+	withLocalHolidayProvider struct {
+		provider HolidayProvider
+	}
+)
+
+var (
+	_ Resolver = (*Client)(nil)
+	_ Resolver = (*FallbackResolver)(nil)
+)
+
+// String implements fmt.Stringer interface.
+func (s Source) String() string {
+	switch s {
+	case SourceRemote:
+		return "remote"
+	case SourceLocal:
+		return "local"
+	default:
+		return fmt.Sprintf("Source(%d)", int(s))
+	}
+}
+
+// Apply implements kenall.FallbackOption interface.
+func (w *withLocalAddressResolver) Apply(r *FallbackResolver) {
+	r.address = w.resolver
+}
+
+// Apply implements kenall.FallbackOption interface.
+func (w *withLocalCityResolver) Apply(r *FallbackResolver) {
+	r.city = w.resolver
+}
+
+// Apply implements kenall.FallbackOption interface.
+func (w *withLocalHolidayProvider) Apply(r *FallbackResolver) {
+	r.holiday = w.provider
+}
+
+// WithLocalAddressResolver injects the local resolver of addresses, e.g. kenall/offline.Dataset.
+func WithLocalAddressResolver(resolver AddressResolver) FallbackOption {
+	return &withLocalAddressResolver{resolver: resolver}
+}
+
+// WithLocalCityResolver injects the local resolver of cities.
+func WithLocalCityResolver(resolver CityResolver) FallbackOption {
+	return &withLocalCityResolver{resolver: resolver}
+}
+
+// WithLocalHolidayProvider injects the local provider of holidays.
+func WithLocalHolidayProvider(provider HolidayProvider) FallbackOption {
+	return &withLocalHolidayProvider{provider: provider}
+}
+
+// NewFallbackResolver creates kenall.FallbackResolver with the remote resolver, which is usually kenall.Client.
+// The operations without the local resolver always answer from the remote one.
+func NewFallbackResolver(remote Resolver, opts ...FallbackOption) (*FallbackResolver, error) {
+	if remote == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	//nolint: exhaustruct
+	r := &FallbackResolver{remote: remote}
+	for _, opt := range opts {
+		opt.Apply(r)
+	}
+
+	return r, nil
+}
+
+// GetAddress implements kenall.AddressResolver interface.
+func (r *FallbackResolver) GetAddress(ctx context.Context, postalCode string) (*GetAddressResponse, error) {
+	res, _, err := r.ResolveAddress(ctx, postalCode)
+
+	return res, err
+}
+
+// GetCity implements kenall.CityResolver interface.
+func (r *FallbackResolver) GetCity(ctx context.Context, prefectureCode string) (*GetCityResponse, error) {
+	res, _, err := r.ResolveCity(ctx, prefectureCode)
+
+	return res, err
+}
+
+// GetHolidays implements kenall.HolidayProvider interface.
+func (r *FallbackResolver) GetHolidays(ctx context.Context) (*GetHolidaysResponse, error) {
+	res, _, err := r.ResolveHolidays(ctx)
+
+	return res, err
+}
+
+// ResolveAddress resolves the addresses by postal code, and reports the source that answered.
+func (r *FallbackResolver) ResolveAddress(
+	ctx context.Context, postalCode string,
+) (*GetAddressResponse, Source, error) {
+	var local func(context.Context) (*GetAddressResponse, error)
+	if r.address != nil {
+		local = func(ctx context.Context) (*GetAddressResponse, error) { return r.address.GetAddress(ctx, postalCode) }
+	}
+
+	return fallback(ctx, func(ctx context.Context) (*GetAddressResponse, error) {
+		return r.remote.GetAddress(ctx, postalCode)
+	}, local)
+}
+
+// ResolveCity resolves the cities by prefecture code, and reports the source that answered.
+func (r *FallbackResolver) ResolveCity(ctx context.Context, prefectureCode string) (*GetCityResponse, Source, error) {
+	var local func(context.Context) (*GetCityResponse, error)
+	if r.city != nil {
+		local = func(ctx context.Context) (*GetCityResponse, error) { return r.city.GetCity(ctx, prefectureCode) }
+	}
+
+	return fallback(ctx, func(ctx context.Context) (*GetCityResponse, error) {
+		return r.remote.GetCity(ctx, prefectureCode)
+	}, local)
+}
+
+// ResolveHolidays provides the national holidays of Japan, and reports the source that answered.
+func (r *FallbackResolver) ResolveHolidays(ctx context.Context) (*GetHolidaysResponse, Source, error) {
+	var local func(context.Context) (*GetHolidaysResponse, error)
+	if r.holiday != nil {
+		local = r.holiday.GetHolidays
+	}
+
+	return fallback(ctx, r.remote.GetHolidays, local)
+}
+
+func fallback[T any](
+	ctx context.Context, remote, local func(context.Context) (T, error),
+) (T, Source, error) {
+	res, err := remote(ctx)
+	if err == nil || local == nil || !shouldFallback(err) {
+		return res, SourceRemote, err
+	}
+
+	// The local source must answer even if the remote one has used up the deadline of the caller.
+	if ctx.Err() != nil {
+		ctx = context.WithoutCancel(ctx)
+	}
+
+	res, lerr := local(ctx)
+	if lerr != nil {
+		return res, SourceLocal, fmt.Errorf("kenall: failed to fall back to the local source: %w", errors.Join(lerr, err))
+	}
+
+	return res, SourceLocal, nil
+}
+
+// shouldFallback reports whether the error means the kenall service is unavailable for the client.
+func shouldFallback(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError || errors.Is(err, ErrPaymentRequired)
+	}
+
+	if errors.Is(err, ErrInvalidArgument) {
+		return false
+	}
+
+	switch ErrorClass(err) {
+	case ErrorClassTimeout, ErrorClassTransport:
+		return true
+	default:
+		return false
+	}
+}
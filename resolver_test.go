@@ -0,0 +1,220 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+type localResolver struct {
+	err error
+}
+
+func (lr localResolver) GetAddress(ctx context.Context, _ string) (*kenall.GetAddressResponse, error) {
+	// As with kenall/offline.Dataset, the local resolver honors the context.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if lr.err != nil {
+		return nil, lr.err
+	}
+
+	return &kenall.GetAddressResponse{Addresses: []*kenall.Address{{Town: "local"}}}, nil
+}
+
+func (lr localResolver) GetCity(context.Context, string) (*kenall.GetCityResponse, error) {
+	if lr.err != nil {
+		return nil, lr.err
+	}
+
+	return &kenall.GetCityResponse{Cities: []*kenall.City{{City: "local"}}}, nil
+}
+
+func (lr localResolver) GetHolidays(context.Context) (*kenall.GetHolidaysResponse, error) {
+	if lr.err != nil {
+		return nil, lr.err
+	}
+
+	return &kenall.GetHolidaysResponse{Holidays: []*kenall.Holiday{{Title: "local"}}}, nil
+}
+
+func TestNewFallbackResolver(t *testing.T) {
+	t.Parallel()
+
+	if _, err := kenall.NewFallbackResolver(nil); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+}
+
+func TestSource_String(t *testing.T) {
+	t.Parallel()
+
+	for give, want := range map[kenall.Source]string{
+		kenall.SourceRemote: "remote",
+		kenall.SourceLocal:  "local",
+		kenall.Source(9):    "Source(9)",
+	} {
+		if give.String() != want {
+			t.Errorf("give: %v, want: %v", give.String(), want)
+		}
+	}
+}
+
+func TestFallbackResolver_ResolveAddress(t *testing.T) {
+	t.Parallel()
+
+	// The postal code tells the server which status to respond, and 0000000 never responds in time.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		code := strings.TrimPrefix(r.URL.Path, "/postalcode/")
+		if code == "0000000" {
+			<-r.Context().Done()
+
+			return
+		}
+
+		status, _ := strconv.Atoi(code[:3])
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+
+			return
+		}
+		if _, err := w.Write(addressResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL),
+		kenall.WithHTTPClient(&http.Client{Timeout: 50 * time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localErr := errors.New("local error")
+	cases := map[string]struct {
+		give       string
+		local      kenall.AddressResolver
+		wantTown   string
+		wantSource kenall.Source
+		wantError  error
+	}{
+		"OK":                    {give: "2000000", local: localResolver{}, wantTown: "西新宿", wantSource: kenall.SourceRemote, wantError: nil},
+		"Not found":             {give: "4040000", local: localResolver{}, wantTown: "", wantSource: kenall.SourceRemote, wantError: kenall.ErrNotFound},
+		"Invalid argument":      {give: "1", local: localResolver{}, wantTown: "", wantSource: kenall.SourceRemote, wantError: kenall.ErrInvalidArgument},
+		"Payment required":      {give: "4020000", local: localResolver{}, wantTown: "local", wantSource: kenall.SourceLocal, wantError: nil},
+		"Internal server error": {give: "5000000", local: localResolver{}, wantTown: "local", wantSource: kenall.SourceLocal, wantError: nil},
+		"Bad gateway":           {give: "5020000", local: localResolver{}, wantTown: "local", wantSource: kenall.SourceLocal, wantError: nil},
+		"Service unavailable":   {give: "5030000", local: localResolver{}, wantTown: "local", wantSource: kenall.SourceLocal, wantError: nil},
+		"Timeout":               {give: "0000000", local: localResolver{}, wantTown: "local", wantSource: kenall.SourceLocal, wantError: nil},
+		"No local resolver":     {give: "5030000", local: nil, wantTown: "", wantSource: kenall.SourceRemote, wantError: kenall.ErrServiceUnavailable},
+		"Local error":           {give: "5030000", local: localResolver{err: localErr}, wantTown: "", wantSource: kenall.SourceLocal, wantError: localErr},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var opts []kenall.FallbackOption
+			if c.local != nil {
+				opts = append(opts, kenall.WithLocalAddressResolver(c.local))
+			}
+			r, err := kenall.NewFallbackResolver(cli, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, src, err := r.ResolveAddress(t.Context(), c.give)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if src != c.wantSource {
+				t.Errorf("give: %v, want: %v", src, c.wantSource)
+			}
+			if c.wantError != nil {
+				return
+			}
+			if res.Addresses[0].Town != c.wantTown {
+				t.Errorf("give: %v, want: %v", res.Addresses[0].Town, c.wantTown)
+			}
+		})
+	}
+
+	t.Run("Local error keeps the remote error", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := kenall.NewFallbackResolver(cli, kenall.WithLocalAddressResolver(localResolver{err: localErr}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := r.GetAddress(t.Context(), "5030000"); !errors.Is(err, kenall.ErrServiceUnavailable) {
+			t.Errorf("give: %v, want: %v", err, kenall.ErrServiceUnavailable)
+		}
+	})
+
+	t.Run("Deadline of the context", func(t *testing.T) {
+		t.Parallel()
+
+		cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := kenall.NewFallbackResolver(cli, kenall.WithLocalAddressResolver(localResolver{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		t.Cleanup(cancel)
+
+		res, src, err := r.ResolveAddress(ctx, "0000000")
+		if err != nil {
+			t.Fatalf("give: %v, want: %v", err, nil)
+		}
+		if src != kenall.SourceLocal || res.Addresses[0].Town != "local" {
+			t.Errorf("give: %v, want: %v", src, kenall.SourceLocal)
+		}
+	})
+}
+
+func TestFallbackResolver_ResolveCityAndHolidays(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := kenall.NewFallbackResolver(cli,
+		kenall.WithLocalCityResolver(localResolver{}), kenall.WithLocalHolidayProvider(localResolver{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	city, src, err := r.ResolveCity(t.Context(), "13")
+	if err != nil || src != kenall.SourceLocal || city.Cities[0].City != "local" {
+		t.Errorf("give: %v, %v, want: %v", err, src, kenall.SourceLocal)
+	}
+	if _, err := r.GetCity(t.Context(), "13"); err != nil {
+		t.Errorf("give: %v, want: %v", err, nil)
+	}
+
+	holidays, src, err := r.ResolveHolidays(t.Context())
+	if err != nil || src != kenall.SourceLocal || holidays.Holidays[0].Title != "local" {
+		t.Errorf("give: %v, %v, want: %v", err, src, kenall.SourceLocal)
+	}
+	if _, err := r.GetHolidays(t.Context()); err != nil {
+		t.Errorf("give: %v, want: %v", err, nil)
+	}
+}
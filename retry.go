@@ -0,0 +1,124 @@
+package kenall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 100 * time.Millisecond
+	defaultRetryMaxBackoff     = 2 * time.Second
+	defaultRetryMaxRetryAfter  = 30 * time.Second
+)
+
+// A RetryPolicy is a policy to retry idempotent requests to the kenall service.
+// Requests are retried on transport errors, timeouts, 429 Too Many Requests and 5xx responses,
+// waiting as long as the Retry-After header asks instead of the backoff if it is given,
+// and giving up if it asks to wait longer than MaxRetryAfter.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one. The default is 3.
+	MaxAttempts int
+	// InitialBackoff is the backoff before the first retry, and it doubles on every retry. The default is 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the backoff between retries. The default is 2s.
+	MaxBackoff time.Duration
+	// MaxRetryAfter caps the wait that the Retry-After header asks for. The default is 30s.
+	MaxRetryAfter time.Duration
+	// PerAttemptTimeout limits the duration of each attempt, if it is positive.
+	PerAttemptTimeout time.Duration
+}
+
+func (p *RetryPolicy) do(req *http.Request, send func(*http.Request) (int, error)) error {
+	maxAttempts := p.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultRetryMaxAttempts
+	}
+
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		maxAttempts = 1
+	}
+
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		code, err := p.attempt(req, send)
+		if err == nil || attempt >= maxAttempts || ctx.Err() != nil || !isRetryableStatus(code) ||
+			errors.Is(err, ErrRateLimited) {
+			return err
+		}
+
+		backoff := p.backoff(attempt)
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+			if apiErr.RetryAfter > p.maxRetryAfter() {
+				return err
+			}
+
+			backoff = apiErr.RetryAfter
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < backoff {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return fmt.Errorf("kenall: gave up retrying: %w: %w", ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+}
+
+func (p *RetryPolicy) attempt(req *http.Request, send func(*http.Request) (int, error)) (int, error) {
+	if p.PerAttemptTimeout <= 0 {
+		return send(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), p.PerAttemptTimeout)
+	defer cancel()
+
+	return send(req.Clone(ctx))
+}
+
+func (p *RetryPolicy) maxRetryAfter() time.Duration {
+	if p.MaxRetryAfter <= 0 {
+		return defaultRetryMaxRetryAfter
+	}
+
+	return p.MaxRetryAfter
+}
+
+// backoff returns the exponential backoff before the next attempt with equal jitter.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	initial, maxBackoff := p.InitialBackoff, p.MaxBackoff
+	if initial <= 0 {
+		initial = defaultRetryInitialBackoff
+	}
+
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	d := initial << (attempt - 1)
+	if d <= 0 || d > maxBackoff {
+		d = maxBackoff
+	}
+
+	half := d / 2 //nolint: mnd
+
+	return half + rand.N(d-half+1) //nolint: gosec
+}
+
+// isRetryableStatus reports whether the request is worth retrying by the status code,
+// where zero means that no response has been received.
+func isRetryableStatus(code int) bool {
+	return code == 0 || code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
@@ -0,0 +1,179 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		giveStatuses []int
+		givePolicy   kenall.RetryPolicy
+		giveTimeout  time.Duration
+		wantError    error
+		wantAttempts int32
+	}{
+		"Success at first":          {giveStatuses: []int{http.StatusOK}, givePolicy: kenall.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}, wantError: nil, wantAttempts: 1},
+		"Retry internal error":      {giveStatuses: []int{http.StatusInternalServerError, http.StatusOK}, givePolicy: kenall.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}, wantError: nil, wantAttempts: 2},
+		"Retry too many requests":   {giveStatuses: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK}, givePolicy: kenall.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}, wantError: nil, wantAttempts: 3},
+		"Exceed max attempts":       {giveStatuses: []int{http.StatusInternalServerError}, givePolicy: kenall.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}, wantError: kenall.ErrInternalServerError, wantAttempts: 3},
+		"Default max attempts":      {giveStatuses: []int{http.StatusInternalServerError}, givePolicy: kenall.RetryPolicy{InitialBackoff: time.Millisecond}, wantError: kenall.ErrInternalServerError, wantAttempts: 3},
+		"Not retry not found":       {giveStatuses: []int{http.StatusNotFound}, givePolicy: kenall.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}, wantError: kenall.ErrNotFound, wantAttempts: 1},
+		"Not retry unauthorized":    {giveStatuses: []int{http.StatusUnauthorized}, givePolicy: kenall.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}, wantError: kenall.ErrUnauthorized, wantAttempts: 1},
+		"Retry per-attempt timeout": {giveStatuses: []int{0, http.StatusOK}, givePolicy: kenall.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, PerAttemptTimeout: 50 * time.Millisecond}, wantError: nil, wantAttempts: 2},
+		"Stop before deadline":      {giveStatuses: []int{http.StatusInternalServerError}, givePolicy: kenall.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: time.Second}, giveTimeout: 500 * time.Millisecond, wantError: kenall.ErrInternalServerError, wantAttempts: 1},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(attempts.Add(1))
+				status := c.giveStatuses[min(n, len(c.giveStatuses))-1]
+				switch status {
+				case 0:
+					<-r.Context().Done()
+				case http.StatusOK:
+					if _, err := w.Write(whoamiResponse); err != nil {
+						w.WriteHeader(http.StatusInternalServerError)
+					}
+				default:
+					w.WriteHeader(status)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithRetryPolicy(c.givePolicy))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := t.Context()
+			if c.giveTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, c.giveTimeout)
+				t.Cleanup(cancel)
+			}
+
+			_, err = cli.GetWhoami(ctx)
+			if c.wantError == nil && err != nil {
+				t.Fatalf("an error should be nil, err = %s", err)
+			}
+			if !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if got := attempts.Load(); got != c.wantAttempts {
+				t.Errorf("give: %v, want: %v", got, c.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		attempts atomic.Int32
+		first    time.Time
+		elapsed  time.Duration
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if attempts.Add(1) == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		elapsed = time.Since(first)
+		if _, err := w.Write(whoamiResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL),
+		kenall.WithRetryPolicy(kenall.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetWhoami(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed < time.Second {
+		t.Errorf("give: %v, want: >= %v", elapsed, time.Second)
+	}
+}
+
+func TestRetryPolicy_MaxRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Retry-After", "86400")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL),
+		kenall.WithRetryPolicy(kenall.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	if _, err := cli.GetWhoami(t.Context()); !errors.Is(err, kenall.ErrServiceUnavailable) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrServiceUnavailable)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("give: %v, want: %v", got, 1)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("give: %v, want: < %v", elapsed, time.Second)
+	}
+}
+
+func TestRetryPolicy_CanceledContext(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL),
+		kenall.WithRetryPolicy(kenall.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Second, MaxBackoff: time.Second}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	_, err = cli.GetWhoami(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("give: %v, want: %v", err, context.Canceled)
+	}
+	if !errors.Is(err, kenall.ErrInternalServerError) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInternalServerError)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("give: %v, want: %v", got, 1)
+	}
+}
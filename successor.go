@@ -0,0 +1,68 @@
+package kenall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// MaxSuccessorDepth is the maximum number of the successors that ResolveSuccessor follows.
+const MaxSuccessorDepth = 10
+
+var (
+	// ErrSuccessorCycle is an error value that will be returned if the successors of the corporation form a cycle.
+	ErrSuccessorCycle = errors.New("kenall: successor corporate numbers form a cycle")
+	// ErrSuccessorTooDeep is an error value that will be returned if the successors exceed MaxSuccessorDepth.
+	ErrSuccessorTooDeep = errors.New("kenall: too many successor corporations")
+)
+
+// A ResolveSuccessorResponse is a result of the successors of the corporation.
+type ResolveSuccessorResponse struct {
+	// Lineage is the corporations from the given one to the last successor in order.
+	Lineage []*Corporation
+	// Current is the last successor if it is active, and nil if it is closed without a successor, e.g. by liquidation.
+	Current *Corporation
+}
+
+// ResolveSuccessor follows the successor corporate numbers of the corporation, which are set after a merger,
+// by requesting to the kenall service repeatedly, and returns the lineage and the current active corporation.
+func (cli *Client) ResolveSuccessor(ctx context.Context, corporateNumber string) (*ResolveSuccessorResponse, error) {
+	seen := make(map[string]struct{}, 1)
+	res := &ResolveSuccessorResponse{Lineage: nil, Current: nil}
+
+	for number := corporateNumber; ; {
+		if _, ok := seen[number]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrSuccessorCycle, number)
+		}
+
+		if len(res.Lineage) > MaxSuccessorDepth {
+			return nil, fmt.Errorf("%w: %s", ErrSuccessorTooDeep, corporateNumber)
+		}
+
+		seen[number] = struct{}{}
+
+		r, err := cli.GetCorporation(ctx, number)
+		if err != nil {
+			return nil, fmt.Errorf("kenall: failed to get the corporation %s: %w", number, err)
+		}
+
+		if r.Corporation == nil {
+			return nil, fmt.Errorf("kenall: failed to get the corporation %s: %w", number, ErrNotFound)
+		}
+
+		res.Lineage = append(res.Lineage, r.Corporation)
+
+		next := r.Corporation.SuccessorCorporateNumber
+		if !next.Valid || next.String == "" {
+			break
+		}
+
+		number = next.String
+	}
+
+	if last := res.Lineage[len(res.Lineage)-1]; !last.IsClosed() {
+		res.Current = last
+	}
+
+	return res, nil
+}
@@ -0,0 +1,129 @@
+package kenall_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+// corporateNumber returns the corporate number of the sequence with the check digit.
+func corporateNumber(t *testing.T, seq int) string {
+	t.Helper()
+
+	base := strconv.Itoa(1000000000000 + seq)[1:]
+	d, err := kenall.CorporateNumberCheckDigit(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return strconv.Itoa(d) + base
+}
+
+func TestClient_ResolveSuccessor(t *testing.T) {
+	t.Parallel()
+
+	// successors maps a sequence to its successor, and the negative one means the corporation is liquidated.
+	successors := map[int]int{
+		1: 2, 2: 3, 3: 0, // merged twice into the active corporation
+		10: -1,         // liquidated
+		20: 21, 21: 20, // cycle
+		40: 41, 41: 0, // merged into the missing corporation
+	}
+	for i := range kenall.MaxSuccessorDepth + 1 {
+		successors[100+i] = 101 + i
+	}
+
+	numbers := map[string]int{}
+	for seq := range successors {
+		numbers[corporateNumber(t, seq)] = seq
+	}
+	numbers[corporateNumber(t, 111)] = 111
+	delete(numbers, corporateNumber(t, 41))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		number := strings.TrimPrefix(r.URL.Path, "/houjinbangou/")
+		seq, ok := numbers[number]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		corp := map[string]any{"corporate_number": number, "successor_corporate_number": nil, "close_cause": nil}
+		switch next := successors[seq]; {
+		case next > 0:
+			corp["successor_corporate_number"] = corporateNumber(t, next)
+			corp["close_cause"] = "11"
+		case next < 0:
+			corp["close_cause"] = "01"
+		}
+
+		if err := json.NewEncoder(w).Encode(map[string]any{"version": "2022-02-01", "data": corp}); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		give        int
+		wantLineage []int
+		wantCurrent int
+		wantError   error
+	}{
+		"Active":     {give: 3, wantLineage: []int{3}, wantCurrent: 3, wantError: nil},
+		"Merged":     {give: 1, wantLineage: []int{1, 2, 3}, wantCurrent: 3, wantError: nil},
+		"Liquidated": {give: 10, wantLineage: []int{10}, wantCurrent: 0, wantError: nil},
+		"Cycle":      {give: 20, wantLineage: nil, wantCurrent: 0, wantError: kenall.ErrSuccessorCycle},
+		"Missing":    {give: 40, wantLineage: nil, wantCurrent: 0, wantError: kenall.ErrNotFound},
+		"Too deep":   {give: 100, wantLineage: nil, wantCurrent: 0, wantError: kenall.ErrSuccessorTooDeep},
+		"Max depth":  {give: 101, wantLineage: []int{101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111}, wantCurrent: 111, wantError: nil},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := cli.ResolveSuccessor(t.Context(), corporateNumber(t, c.give))
+			if !errors.Is(err, c.wantError) || (c.wantError == nil && err != nil) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if c.wantError != nil {
+				return
+			}
+
+			lineage := make([]string, 0, len(res.Lineage))
+			for _, corp := range res.Lineage {
+				lineage = append(lineage, corp.CorporateNumber)
+			}
+			want := make([]string, 0, len(c.wantLineage))
+			for _, seq := range c.wantLineage {
+				want = append(want, corporateNumber(t, seq))
+			}
+			if strings.Join(lineage, ",") != strings.Join(want, ",") {
+				t.Errorf("give: %v, want: %v", lineage, want)
+			}
+
+			switch {
+			case c.wantCurrent == 0 && res.Current != nil:
+				t.Errorf("give: %v, want: %v", res.Current.CorporateNumber, nil)
+			case c.wantCurrent != 0 && (res.Current == nil || res.Current.CorporateNumber != corporateNumber(t, c.wantCurrent)):
+				t.Errorf("give: %v, want: %v", res.Current, corporateNumber(t, c.wantCurrent))
+			}
+		})
+	}
+
+	if _, err := cli.ResolveSuccessor(t.Context(), "1021001052596"); !errors.Is(err, kenall.ErrInvalidCheckDigit) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidCheckDigit)
+	}
+}
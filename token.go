@@ -0,0 +1,75 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// tokenExpiryDelta is the margin to refresh the token before it expires.
+const tokenExpiryDelta = 10 * time.Second
+
+type (
+	// A TokenSource provides the authorization token of the kenall service, e.g. from a secret manager.
+	TokenSource interface {
+		// Token returns the current token. It is called only when the cached token has expired.
+		Token(ctx context.Context) (*Token, error)
+	}
+	// A Token is the authorization token of the kenall service.
+	Token struct {
+		// Value is the token issued by the kenall service.
+		Value string
+		// Expiry is the time when the token should be refreshed. The zero value never expires.
+		Expiry time.Time
+	}
+	// A TokenSourceFunc is an adapter to use the ordinary function as kenall.TokenSource.
+	TokenSourceFunc func(ctx context.Context) (*Token, error)
+
+	cachedTokenSource struct {
+		mu                    sync.Mutex
+		src                   TokenSource
+		cached                *Token
+		refreshOnUnauthorized bool
+	}
+)
+
+var _ TokenSource = TokenSourceFunc(nil)
+
+// Token implements kenall.TokenSource interface.
+func (f TokenSourceFunc) Token(ctx context.Context) (*Token, error) {
+	return f(ctx)
+}
+
+func (t *Token) valid(now time.Time) bool {
+	return t != nil && t.Value != "" && (t.Expiry.IsZero() || now.Add(tokenExpiryDelta).Before(t.Expiry))
+}
+
+func (s *cachedTokenSource) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached.valid(time.Now()) {
+		return s.cached.Value, nil
+	}
+
+	t, err := s.src.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("kenall: failed to get the token: %w", err)
+	}
+
+	if t == nil || t.Value == "" {
+		return "", fmt.Errorf("kenall: failed to get the token: %w", ErrInvalidArgument)
+	}
+
+	s.cached = t
+
+	return t.Value, nil
+}
+
+func (s *cachedTokenSource) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cached = nil
+}
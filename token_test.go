@@ -0,0 +1,88 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_WithTokenSource(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	errSecretManager := errors.New("secret manager is unavailable")
+
+	cases := map[string]struct {
+		giveTokens    []*kenall.Token
+		giveRefresh   bool
+		giveCalls     int
+		wantError     error
+		wantTokenGets int32
+	}{
+		"Static token never expires": {giveTokens: []*kenall.Token{{Value: "opencollector"}}, giveRefresh: false, giveCalls: 3, wantError: nil, wantTokenGets: 1},
+		"Token expires":              {giveTokens: []*kenall.Token{{Value: "opencollector", Expiry: time.Now().Add(time.Second)}}, giveRefresh: false, giveCalls: 3, wantError: nil, wantTokenGets: 3},
+		"Token is cached":            {giveTokens: []*kenall.Token{{Value: "opencollector", Expiry: time.Now().Add(time.Hour)}}, giveRefresh: false, giveCalls: 3, wantError: nil, wantTokenGets: 1},
+		"Refresh on unauthorized":    {giveTokens: []*kenall.Token{{Value: "rotated"}, {Value: "opencollector"}}, giveRefresh: true, giveCalls: 2, wantError: nil, wantTokenGets: 2},
+		"No refresh on unauthorized": {giveTokens: []*kenall.Token{{Value: "rotated"}, {Value: "opencollector"}}, giveRefresh: false, giveCalls: 1, wantError: kenall.ErrUnauthorized, wantTokenGets: 1},
+		"Refresh only once":          {giveTokens: []*kenall.Token{{Value: "rotated"}}, giveRefresh: true, giveCalls: 1, wantError: kenall.ErrUnauthorized, wantTokenGets: 2},
+		"Empty token":                {giveTokens: []*kenall.Token{{Value: ""}}, giveRefresh: false, giveCalls: 1, wantError: kenall.ErrInvalidArgument, wantTokenGets: 1},
+		"Token source error":         {giveTokens: nil, giveRefresh: false, giveCalls: 1, wantError: errSecretManager, wantTokenGets: 1},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gets atomic.Int32
+			src := kenall.TokenSourceFunc(func(context.Context) (*kenall.Token, error) {
+				n := int(gets.Add(1))
+				if len(c.giveTokens) == 0 {
+					return nil, errSecretManager
+				}
+
+				return c.giveTokens[min(n, len(c.giveTokens))-1], nil
+			})
+
+			cli, err := kenall.NewClient("", kenall.WithEndpoint(srv.URL), kenall.WithTokenSource(src, c.giveRefresh))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for range c.giveCalls {
+				_, err = cli.GetWhoami(t.Context())
+			}
+			if c.wantError == nil && err != nil {
+				t.Fatalf("an error should be nil, err = %s", err)
+			}
+			if !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if got := gets.Load(); got != c.wantTokenGets {
+				t.Errorf("give: %v, want: %v", got, c.wantTokenGets)
+			}
+		})
+	}
+}
+
+func TestNewClient_WithTokenSource(t *testing.T) {
+	t.Parallel()
+
+	if _, err := kenall.NewClient("", kenall.WithTokenSource(nil, false)); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+
+	cli, err := kenall.NewClient("", kenall.WithHTTPClient(&http.Client{}), kenall.WithTokenSource(kenall.TokenSourceFunc(nil), false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cli == nil {
+		t.Error("a return value should not be nil")
+	}
+}
@@ -0,0 +1,82 @@
+package kenall
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+type (
+	// A Tracer starts a span for each API call of kenall.Client.
+	// It is a small subset of the tracer of OpenTelemetry, and the kenallotel module adapts it to OpenTelemetry,
+	// so that kenall itself does not depend on it.
+	Tracer interface {
+		// Start starts a span named after the operation, e.g. "kenall.GetAddress",
+		// and returns the context that carries the span.
+		Start(ctx context.Context, name string) (context.Context, Span)
+	}
+	// A Span is a span started by kenall.Tracer.
+	Span interface {
+		// SetAttribute sets the attribute of the span, where value is a string, an int or a bool.
+		SetAttribute(key string, value any)
+		// RecordError records the error of the API call.
+		RecordError(err error)
+		// End ends the span.
+		End()
+	}
+)
+
+// The attribute keys of the span started by kenall.Tracer.
+const (
+	// AttributeHTTPMethod is the HTTP method of the request.
+	AttributeHTTPMethod = "http.request.method"
+	// AttributeHTTPRoute is the path template of the endpoint, e.g. "/postalcode/{postal_code}".
+	AttributeHTTPRoute = "http.route"
+	// AttributeHTTPStatusCode is the HTTP status code of the last response, which is not set without responses.
+	AttributeHTTPStatusCode = "http.response.status_code"
+	// AttributeVersion is the version of the retrieved data, which is set if the response has it.
+	AttributeVersion = "kenall.version"
+	// AttributeRetryCount is the number of retries by kenall.RetryPolicy.
+	AttributeRetryCount = "kenall.retry_count"
+	// AttributeCacheHit reports whether the response is served from kenall.Cache without requests.
+	AttributeCacheHit = "kenall.cache_hit"
+)
+
+func endSpan(span Span, op operation, req *http.Request, c *call, res any, err error) {
+	span.SetAttribute(AttributeHTTPMethod, req.Method)
+	span.SetAttribute(AttributeHTTPRoute, op.route)
+
+	if c.statusCode != 0 {
+		span.SetAttribute(AttributeHTTPStatusCode, c.statusCode)
+	}
+
+	span.SetAttribute(AttributeRetryCount, max(0, c.attempts-1))
+	span.SetAttribute(AttributeCacheHit, c.cacheHit)
+
+	if err != nil {
+		span.RecordError(err)
+	} else if v, ok := responseVersion(res); ok {
+		span.SetAttribute(AttributeVersion, time.Time(v).Format(RFC3339DateFormat))
+	}
+
+	span.End()
+}
+
+// responseVersion returns the Version field of the response, if any.
+func responseVersion(res any) (Version, bool) {
+	rv := reflect.Indirect(reflect.ValueOf(res))
+	if rv.Kind() != reflect.Struct {
+		return Version{}, false
+	}
+
+	f := rv.FieldByName("Version")
+	if !f.IsValid() || f.Type() != reflect.TypeFor[Version]() {
+		return Version{}, false
+	}
+
+	//nolint: forcetypeassert
+	v := f.Interface().(Version)
+
+	return v, !time.Time(v).IsZero()
+}
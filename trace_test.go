@@ -0,0 +1,135 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+type (
+	recordingTracer struct {
+		mu    sync.Mutex
+		spans []*recordingSpan
+	}
+	recordingSpan struct {
+		name       string
+		attributes map[string]any
+		errs       []error
+		ended      bool
+	}
+)
+
+func (rt *recordingTracer) Start(ctx context.Context, name string) (context.Context, kenall.Span) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	span := &recordingSpan{name: name, attributes: map[string]any{}}
+	rt.spans = append(rt.spans, span)
+
+	return ctx, span
+}
+
+func (rs *recordingSpan) SetAttribute(key string, value any) { rs.attributes[key] = value }
+func (rs *recordingSpan) RecordError(err error)              { rs.errs = append(rs.errs, err) }
+func (rs *recordingSpan) End()                               { rs.ended = true }
+
+func TestClient_WithTracer(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	tracer := &recordingTracer{}
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithTracer(tracer),
+		kenall.WithRetryPolicy(kenall.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}),
+		kenall.WithCache(kenall.NewLRUCache(10)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetAddress(t.Context(), "1008105"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.GetAddress(t.Context(), "1008105"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.GetCity(t.Context(), "92"); !errors.Is(err, kenall.ErrInternalServerError) {
+		t.Fatalf("give: %v, want: %v", err, kenall.ErrInternalServerError)
+	}
+
+	cases := []struct {
+		wantName       string
+		wantAttributes map[string]any
+		wantError      error
+	}{
+		{
+			wantName: "kenall.GetAddress",
+			wantAttributes: map[string]any{
+				kenall.AttributeHTTPMethod:     "GET",
+				kenall.AttributeHTTPRoute:      "/postalcode/{postal_code}",
+				kenall.AttributeHTTPStatusCode: 200,
+				kenall.AttributeVersion:        "2021-06-30",
+				kenall.AttributeRetryCount:     0,
+				kenall.AttributeCacheHit:       false,
+			},
+			wantError: nil,
+		},
+		{
+			wantName: "kenall.GetAddress",
+			wantAttributes: map[string]any{
+				kenall.AttributeHTTPMethod: "GET",
+				kenall.AttributeHTTPRoute:  "/postalcode/{postal_code}",
+				kenall.AttributeVersion:    "2021-06-30",
+				kenall.AttributeRetryCount: 0,
+				kenall.AttributeCacheHit:   true,
+			},
+			wantError: nil,
+		},
+		{
+			wantName: "kenall.GetCity",
+			wantAttributes: map[string]any{
+				kenall.AttributeHTTPMethod:     "GET",
+				kenall.AttributeHTTPRoute:      "/cities/{prefecture_code}",
+				kenall.AttributeHTTPStatusCode: 500,
+				kenall.AttributeRetryCount:     1,
+				kenall.AttributeCacheHit:       false,
+			},
+			wantError: kenall.ErrInternalServerError,
+		},
+	}
+
+	if len(tracer.spans) != len(cases) {
+		t.Fatalf("give: %v, want: %v", len(tracer.spans), len(cases))
+	}
+
+	for i, c := range cases {
+		span := tracer.spans[i]
+		if span.name != c.wantName {
+			t.Errorf("give: %v, want: %v", span.name, c.wantName)
+		}
+		if !span.ended {
+			t.Errorf("span %d should be ended", i)
+		}
+		if len(span.attributes) != len(c.wantAttributes) {
+			t.Errorf("give: %v, want: %v", span.attributes, c.wantAttributes)
+		}
+		for k, want := range c.wantAttributes {
+			if span.attributes[k] != want {
+				t.Errorf("%s: give: %v, want: %v", k, span.attributes[k], want)
+			}
+		}
+		if c.wantError == nil && len(span.errs) != 0 {
+			t.Errorf("give: %v, want: %v", span.errs, nil)
+		}
+		if c.wantError != nil && (len(span.errs) != 1 || !errors.Is(span.errs[0], c.wantError)) {
+			t.Errorf("give: %v, want: %v", span.errs, c.wantError)
+		}
+	}
+}
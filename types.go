@@ -18,7 +18,16 @@ type (
 		String string
 		Valid  bool // Valid is true if String is not NULL
 	}
+	// A Date represents a date in JST that may be null.
+	Date struct {
+		Time  time.Time
+		Valid bool // Valid is true if Time is not NULL
+	}
 
+	businessDay struct {
+		Date         string `json:"date"`
+		LegalHoliday bool   `json:"is_legal_holiday"`
+	}
 	holiday struct {
 		Title         string `json:"title"`
 		Date          string `json:"date"`
@@ -70,40 +79,40 @@ type (
 	}
 	// A Corporation is a corporation associated with the corporate number defined by National Tax Agency Japan.
 	Corporation struct {
-		PublishedDate            string      `json:"published_date"`
-		SequenceNumber           json.Number `json:"sequence_number"`
-		CorporateNumber          string      `json:"corporate_number"`
-		Process                  json.Number `json:"process"`
-		Correct                  json.Number `json:"correct"`
-		UpdateDate               string      `json:"update_date"`
-		ChangeDate               string      `json:"change_date"`
-		Name                     string      `json:"name"`
-		NameImageID              NullString  `json:"name_image_id"`
-		Kind                     string      `json:"kind"`
-		PrefectureName           string      `json:"prefecture_name"`
-		CityName                 string      `json:"city_name"`
-		StreetNumber             string      `json:"street_number"`
-		Town                     NullString  `json:"town"`
-		KyotoStreet              NullString  `json:"kyoto_street"`
-		BlockLotNum              NullString  `json:"block_lot_num"`
-		Building                 NullString  `json:"building"`
-		FloorRoom                NullString  `json:"floor_room"`
-		AddressImageID           NullString  `json:"address_image_id"`
-		JISX0402                 string      `json:"jisx0402"`
-		PostCode                 string      `json:"post_code"`
-		AddressOutside           string      `json:"address_outside"`
-		AddressOutsideImageID    NullString  `json:"address_outside_image_id"`
-		CloseDate                NullString  `json:"close_date"`
-		CloseCause               NullString  `json:"close_cause"`
-		SuccessorCorporateNumber NullString  `json:"successor_corporate_number"`
-		ChangeCause              string      `json:"change_cause"`
-		AssignmentDate           string      `json:"assignment_date"`
-		EnName                   string      `json:"en_name"`
-		EnPrefectureName         string      `json:"en_prefecture_name"`
-		EnAddressLine            NullString  `json:"en_address_line"`
-		EnAddressOutside         NullString  `json:"en_address_outside"`
-		Furigana                 string      `json:"furigana"`
-		Hihyoji                  string      `json:"hihyoji"`
+		PublishedDate            Date            `json:"published_date"`
+		SequenceNumber           SequenceNumber  `json:"sequence_number"`
+		CorporateNumber          string          `json:"corporate_number"`
+		Process                  ProcessCode     `json:"process"`
+		Correct                  CorrectCode     `json:"correct"`
+		UpdateDate               Date            `json:"update_date"`
+		ChangeDate               Date            `json:"change_date"`
+		Name                     string          `json:"name"`
+		NameImageID              NullString      `json:"name_image_id"`
+		Kind                     CorporationKind `json:"kind"`
+		PrefectureName           string          `json:"prefecture_name"`
+		CityName                 string          `json:"city_name"`
+		StreetNumber             string          `json:"street_number"`
+		Town                     NullString      `json:"town"`
+		KyotoStreet              NullString      `json:"kyoto_street"`
+		BlockLotNum              NullString      `json:"block_lot_num"`
+		Building                 NullString      `json:"building"`
+		FloorRoom                NullString      `json:"floor_room"`
+		AddressImageID           NullString      `json:"address_image_id"`
+		JISX0402                 string          `json:"jisx0402"`
+		PostCode                 string          `json:"post_code"`
+		AddressOutside           string          `json:"address_outside"`
+		AddressOutsideImageID    NullString      `json:"address_outside_image_id"`
+		CloseDate                Date            `json:"close_date"`
+		CloseCause               CloseCauseCode  `json:"close_cause"`
+		SuccessorCorporateNumber NullString      `json:"successor_corporate_number"`
+		ChangeCause              string          `json:"change_cause"`
+		AssignmentDate           Date            `json:"assignment_date"`
+		EnName                   string          `json:"en_name"`
+		EnPrefectureName         string          `json:"en_prefecture_name"`
+		EnAddressLine            NullString      `json:"en_address_line"`
+		EnAddressOutside         NullString      `json:"en_address_outside"`
+		Furigana                 string          `json:"furigana"`
+		Hihyoji                  string          `json:"hihyoji"`
 	}
 	// A RemoteAddress is an IP address from access point.
 	RemoteAddress struct {
@@ -139,6 +148,16 @@ type (
 		Bank      Bank               `json:"bank"`
 		BranchMap map[string]*Branch `json:"branches"`
 	}
+	// A Facet is the number of addresses aggregated by the path of the area, e.g. "/東京都/港区".
+	Facet struct {
+		Path  string
+		Count int
+	}
+	// A BankBranch is a branch with the bank it belongs to.
+	BankBranch struct {
+		Bank   Bank    `json:"bank"`
+		Branch *Branch `json:"branch"`
+	}
 	// A Query is data normalized to an address.
 	Query struct {
 		Q           NullString `json:"q"`
@@ -163,10 +182,17 @@ var (
 
 	_ json.Unmarshaler = (*Version)(nil)
 	_ json.Unmarshaler = (*NullString)(nil)
+	_ json.Unmarshaler = (*Date)(nil)
 	_ json.Unmarshaler = (*RemoteAddress)(nil)
+	_ json.Unmarshaler = (*Facet)(nil)
 	_ json.Unmarshaler = (*Holiday)(nil)
 	_ json.Unmarshaler = (*BusinessDay)(nil)
 
+	_ json.Marshaler = (*Version)(nil)
+	_ json.Marshaler = (*Address)(nil)
+	_ json.Marshaler = (*NullString)(nil)
+	_ json.Marshaler = (*Date)(nil)
+	_ json.Marshaler = (*Facet)(nil)
 	_ json.Marshaler = (*Holiday)(nil)
 	_ json.Marshaler = (*BusinessDay)(nil)
 
@@ -189,6 +215,16 @@ func (v *Version) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler interface, and returns null for the zero value.
+func (v Version) MarshalJSON() ([]byte, error) {
+	if time.Time(v).IsZero() {
+		return nullLiteral, nil
+	}
+
+	//nolint: wrapcheck
+	return json.Marshal(time.Time(v).Format(RFC3339DateFormat))
+}
+
 // UnmarshalJSON implements json.Unmarshaler interface.
 func (ns *NullString) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, nullLiteral) {
@@ -204,6 +240,77 @@ func (ns *NullString) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler interface.
+func (ns NullString) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return nullLiteral, nil
+	}
+
+	//nolint: wrapcheck
+	return json.Marshal(ns.String)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface. An empty string is also treated as null.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s NullString
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("kenall: failed to parse Date: %w", err)
+	}
+
+	if s.String == "" {
+		*d = Date{}
+
+		return nil
+	}
+
+	t, err := time.ParseInLocation(RFC3339DateFormat, s.String, jst)
+	if err != nil {
+		return fmt.Errorf("kenall: failed to parse Date: %w", err)
+	}
+
+	*d = Date{Time: t, Valid: true}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return nullLiteral, nil
+	}
+
+	//nolint: wrapcheck
+	return json.Marshal(d.Time.In(jst).Format(RFC3339DateFormat))
+}
+
+// String implements fmt.Stringer interface, and returns the date in RFC3339DateFormat, or empty if it is null.
+func (d Date) String() string {
+	if !d.Valid {
+		return ""
+	}
+
+	return d.Time.In(jst).Format(RFC3339DateFormat)
+}
+
+// MarshalJSON implements json.Marshaler interface, and returns null for the corporation if it is empty,
+// as the kenall service does for the address of a town.
+func (a Address) MarshalJSON() ([]byte, error) {
+	type Alias Address
+
+	tmp := struct {
+		Alias
+		Corporation any `json:"corporation"`
+	}{Alias: Alias(a), Corporation: a.Corporation}
+
+	//nolint: exhaustruct
+	if a.Corporation == (Address{}).Corporation {
+		tmp.Corporation = nil
+	}
+
+	//nolint: wrapcheck
+	return json.Marshal(&tmp)
+}
+
 // UnmarshalJSON implements json.Unmarshaler interface.
 func (ra *RemoteAddress) UnmarshalJSON(data []byte) error {
 	type Alias RemoteAddress
@@ -242,6 +349,35 @@ func (ra *RemoteAddress) String() string {
 	return ra.IPAddr.String()
 }
 
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (f *Facet) UnmarshalJSON(data []byte) error {
+	var tmp []json.RawMessage
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return fmt.Errorf("kenall: failed to parse Facet: %w", err)
+	}
+
+	if len(tmp) != 2 { //nolint: mnd
+		//nolint: goerr113
+		return fmt.Errorf("kenall: unexpected length of Facet, length = %d", len(tmp))
+	}
+
+	if err := json.Unmarshal(tmp[0], &f.Path); err != nil {
+		return fmt.Errorf("kenall: failed to parse Facet: %w", err)
+	}
+
+	if err := json.Unmarshal(tmp[1], &f.Count); err != nil {
+		return fmt.Errorf("kenall: failed to parse Facet: %w", err)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (f Facet) MarshalJSON() ([]byte, error) {
+	//nolint: wrapcheck
+	return json.Marshal([]any{f.Path, f.Count})
+}
+
 // UnmarshalJSON implements json.Unmarshaler interface.
 func (h *Holiday) UnmarshalJSON(data []byte) error {
 	var tmp holiday
@@ -269,3 +405,30 @@ func (h Holiday) MarshalJSON() ([]byte, error) {
 		DayOfWeekText: strings.ToLower(h.Weekday().String()),
 	})
 }
+
+// UnmarshalJSON implements json.Unmarshaler interface, and reads the format written by MarshalJSON.
+func (bd *BusinessDay) UnmarshalJSON(data []byte) error {
+	var tmp businessDay
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return fmt.Errorf("kenall: failed to parse BusinessDay: %w", err)
+	}
+
+	var err error
+	if bd.Time, err = time.ParseInLocation(RFC3339DateFormat, tmp.Date, jst); err != nil {
+		return fmt.Errorf("kenall: failed to parse BusinessDay: %w", err)
+	}
+
+	bd.LegalHoliday = tmp.LegalHoliday
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface.
+// The format is specific to this library, since the kenall service responds only whether the day is a legal holiday.
+func (bd BusinessDay) MarshalJSON() ([]byte, error) {
+	//nolint: wrapcheck
+	return json.Marshal(&businessDay{
+		Date:         bd.Format(RFC3339DateFormat),
+		LegalHoliday: bd.LegalHoliday,
+	})
+}
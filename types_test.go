@@ -2,6 +2,8 @@ package kenall_test
 
 import (
 	"bytes"
+	"encoding/json"
+	"reflect"
 	"testing"
 	"time"
 
@@ -70,6 +72,73 @@ func TestNullString_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestDate_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	cases := map[string]struct {
+		give      string
+		want      time.Time
+		wantValid bool
+		wantError bool
+	}{
+		"Give 2021-01-12": {give: `"2021-01-12"`, want: time.Date(2021, 1, 12, 0, 0, 0, 0, jst), wantValid: true, wantError: false},
+		"Give empty":      {give: `""`, want: time.Time{}, wantValid: false, wantError: false},
+		"Give null":       {give: `null`, want: time.Time{}, wantValid: false, wantError: false},
+		"Give 20210112":   {give: `"20210112"`, want: time.Time{}, wantValid: false, wantError: true},
+		"Give number":     {give: `20210112`, want: time.Time{}, wantValid: false, wantError: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := &kenall.Date{}
+			err := d.UnmarshalJSON([]byte(c.give))
+			if err == nil == c.wantError {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if d.Valid != c.wantValid {
+				t.Errorf("give: %v, want: %v", d.Valid, c.wantValid)
+			}
+			if !c.want.Equal(d.Time) {
+				t.Errorf("give: %v, want: %v", d.Time, c.want)
+			}
+		})
+	}
+}
+
+func TestDate_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give       kenall.Date
+		want       string
+		wantString string
+	}{
+		"Give date":      {give: kenall.Date{Time: time.Date(2021, 1, 12, 0, 0, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)), Valid: true}, want: `"2021-01-12"`, wantString: "2021-01-12"},
+		"Give UTC date":  {give: kenall.Date{Time: time.Date(2021, 1, 11, 15, 0, 0, 0, time.UTC), Valid: true}, want: `"2021-01-12"`, wantString: "2021-01-12"},
+		"Give null date": {give: kenall.Date{Time: time.Time{}, Valid: false}, want: `null`, wantString: ""},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := c.give.MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != c.want {
+				t.Errorf("give: %v, want: %v", string(b), c.want)
+			}
+			if c.give.String() != c.wantString {
+				t.Errorf("give: %v, want: %v", c.give.String(), c.wantString)
+			}
+		})
+	}
+}
+
 func TestRemoteAddress_UnmarshalJSON(t *testing.T) {
 	t.Parallel()
 
@@ -187,3 +256,209 @@ func TestHoliday_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestFacet_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		wantPath  string
+		wantCount int
+		wantError bool
+	}{
+		"Normal case":      {give: `["/東京都/港区",1637]`, wantPath: "/東京都/港区", wantCount: 1637, wantError: false},
+		"Unexpected path":  {give: `[1,1637]`, wantPath: "", wantCount: 0, wantError: true},
+		"Unexpected count": {give: `["/東京都/港区","1637"]`, wantPath: "", wantCount: 0, wantError: true},
+		"Short array":      {give: `["/東京都/港区"]`, wantPath: "", wantCount: 0, wantError: true},
+		"Give object":      {give: `{}`, wantPath: "", wantCount: 0, wantError: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f := &kenall.Facet{}
+			err := f.UnmarshalJSON([]byte(c.give))
+			if c.wantError {
+				if err == nil {
+					t.Errorf("an error should not be nil")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("an error should be nil, err = %s", err)
+			}
+			if f.Path != c.wantPath {
+				t.Errorf("give: %s, want: %s", f.Path, c.wantPath)
+			}
+			if f.Count != c.wantCount {
+				t.Errorf("give: %d, want: %d", f.Count, c.wantCount)
+			}
+		})
+	}
+}
+
+func TestResponses_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give []byte
+		res  any
+	}{
+		"GetAddressResponse":         {give: addressResponse, res: &kenall.GetAddressResponse{}},
+		"SearchAddressResponse":      {give: searchAddressResponse, res: &kenall.SearchAddressResponse{}},
+		"GetCityResponse":            {give: cityResponse, res: &kenall.GetCityResponse{}},
+		"GetCorporationResponse":     {give: corporationResponse, res: &kenall.GetCorporationResponse{}},
+		"SearchCorporationsResponse": {give: searchCorporationsResponse, res: &kenall.SearchCorporationsResponse{}},
+		"GetWhoamiResponse":          {give: whoamiResponse, res: &kenall.GetWhoamiResponse{}},
+		"GetHolidaysResponse":        {give: holidaysResponse, res: &kenall.GetHolidaysResponse{}},
+		"GetBankResponse":            {give: bankResponse, res: &kenall.GetBankResponse{}},
+		"GetBankBranchResponse":      {give: bankBranchResponse, res: &kenall.GetBankBranchResponse{}},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := json.Unmarshal(c.give, c.res); err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.Marshal(c.res)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var give, want any
+			if err := json.Unmarshal(b, &give); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(c.give, &want); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(give, want) {
+				t.Errorf("give: %s, want: %s", b, c.give)
+			}
+		})
+	}
+}
+
+func TestResponses_MarshalJSONBytes(t *testing.T) {
+	t.Parallel()
+
+	// The fields are ordered as the kenall service does.
+	cases := map[string]struct {
+		give []byte
+		res  any
+	}{
+		"GetAddressResponse":     {give: addressResponse, res: &kenall.GetAddressResponse{}},
+		"GetCityResponse":        {give: cityResponse, res: &kenall.GetCityResponse{}},
+		"GetCorporationResponse": {give: corporationResponse, res: &kenall.GetCorporationResponse{}},
+		"GetWhoamiResponse":      {give: whoamiResponse, res: &kenall.GetWhoamiResponse{}},
+		"GetHolidaysResponse":    {give: holidaysResponse, res: &kenall.GetHolidaysResponse{}},
+		"GetBankResponse":        {give: bankResponse, res: &kenall.GetBankResponse{}},
+		"GetBankBranchResponse":  {give: bankBranchResponse, res: &kenall.GetBankBranchResponse{}},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := json.Unmarshal(c.give, c.res); err != nil {
+				t.Fatal(err)
+			}
+			b, err := json.MarshalIndent(c.res, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := bytes.TrimSpace(c.give); !bytes.Equal(b, want) {
+				t.Errorf("give: %s, want: %s", b, want)
+			}
+		})
+	}
+}
+
+func TestSequenceNumber_Int64(t *testing.T) {
+	t.Parallel()
+
+	if n, err := kenall.SequenceNumber("1409569").Int64(); err != nil || n != 1409569 {
+		t.Errorf("give: %v, want: %v", n, 1409569)
+	}
+	if _, err := kenall.SequenceNumber("").Int64(); err == nil {
+		t.Errorf("give: %v, want: %v", err, "an error")
+	}
+}
+
+func TestFacet_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	b, err := json.Marshal(&kenall.Facet{Path: "/東京都/港区", Count: 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `["/東京都/港区",12]`; string(b) != want {
+		t.Errorf("give: %s, want: %s", b, want)
+	}
+}
+
+func TestVersion_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for give, want := range map[kenall.Version]string{
+		kenall.Version(time.Date(2020, 11, 30, 0, 0, 0, 0, time.UTC)): `"2020-11-30"`,
+		kenall.Version(time.Time{}):                                   `null`,
+	} {
+		b, err := json.Marshal(give)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != want {
+			t.Errorf("give: %s, want: %s", b, want)
+		}
+	}
+}
+
+func TestNullString_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	for give, want := range map[kenall.NullString]string{
+		{String: "123", Valid: true}: `"123"`,
+		{String: "", Valid: true}:    `""`,
+		{String: "", Valid: false}:   `null`,
+	} {
+		b, err := json.Marshal(give)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != want {
+			t.Errorf("give: %s, want: %s", b, want)
+		}
+	}
+}
+
+func TestBusinessDay_JSON(t *testing.T) {
+	t.Parallel()
+
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	give := kenall.BusinessDay{LegalHoliday: true, Time: time.Date(2022, 1, 1, 0, 0, 0, 0, jst)}
+
+	b, err := json.Marshal(give)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"date":"2022-01-01","is_legal_holiday":true}`; string(b) != want {
+		t.Errorf("give: %s, want: %s", b, want)
+	}
+
+	var got kenall.BusinessDay
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(give.Time) || got.LegalHoliday != give.LegalHoliday {
+		t.Errorf("give: %v, want: %v", got, give)
+	}
+
+	if err := json.Unmarshal([]byte(`{"date":"20220101"}`), &got); err == nil {
+		t.Errorf("give: %v, want: %v", err, "an error")
+	}
+}